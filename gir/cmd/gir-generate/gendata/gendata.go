@@ -15,9 +15,10 @@ import (
 const Module = "github.com/go-gst/go-glib/pkg"
 
 var Main = genmain.Data{
-	Module:        Module,
-	GirFiles:      girfiles_goglib.GirFiles,
-	Preprocessors: Preprocessors,
+	Module:         Module,
+	GirFiles:       girfiles_goglib.GirFiles,
+	Preprocessors:  Preprocessors,
+	Postprocessors: Postprocessors,
 
 	Documentation: generators.NewGtkGodocGenerator,
 
@@ -66,22 +67,36 @@ var Main = genmain.Data{
 						},
 						TrampolineName: "destroyUserdata",
 					},
-					// TODO: implement Variant
-					// &typesystem.Record{
-					// 	BaseType: typesystem.BaseType{
-					// 		GirName: "Variant",
-					// 		GoTyp:   "Variant",
-					// 		CGoTyp:  "C.GVariant",
-					// 		CTyp:    "GVariant",
-					// 	},
-					// 	BaseConversions: typesystem.BaseConversions{
-					// 		FromGlibBorrowFunction: "UnsafeVariantFromGlibBorrow",
-					// 		FromGlibFullFunction:   "UnsafeVariantFromGlibFull",
-					// 		FromGlibNoneFunction:   "UnsafeVariantFromGlibNone",
-					// 		ToGlibNoneFunction:     "UnsafeVariantToGlibNone",
-					// 		ToGlibFullFunction:     "UnsafeVariantToGlibFull",
-					// 	},
-					// },
+					&typesystem.Record{
+						BaseType: typesystem.BaseType{
+							GirName: "Variant",
+							GoTyp:   "Variant",
+							CGoTyp:  "C.GVariant",
+							CTyp:    "GVariant",
+						},
+						BaseConversions: typesystem.BaseConversions{
+							FromGlibBorrowFunction: "UnsafeVariantFromGlibBorrow",
+							FromGlibFullFunction:   "UnsafeVariantFromGlibFull",
+							FromGlibNoneFunction:   "UnsafeVariantFromGlibNone",
+							ToGlibNoneFunction:     "UnsafeVariantToGlibNone",
+							ToGlibFullFunction:     "UnsafeVariantToGlibFull",
+						},
+					},
+					&typesystem.Record{
+						BaseType: typesystem.BaseType{
+							GirName: "Source",
+							GoTyp:   "Source",
+							CGoTyp:  "C.GSource",
+							CTyp:    "GSource",
+						},
+						BaseConversions: typesystem.BaseConversions{
+							FromGlibBorrowFunction: "UnsafeSourceFromGlibBorrow",
+							FromGlibFullFunction:   "UnsafeSourceFromGlibFull",
+							FromGlibNoneFunction:   "UnsafeSourceFromGlibNone",
+							ToGlibNoneFunction:     "UnsafeSourceToGlibNone",
+							ToGlibFullFunction:     "UnsafeSourceToGlibFull",
+						},
+					},
 					&typesystem.Record{
 						BaseType: typesystem.BaseType{
 							GirName: "Error",
@@ -121,7 +136,7 @@ var Main = genmain.Data{
 					typesystem.IgnoreMatching("unlink"),
 
 					typesystem.IgnoreByRegex("Date.*"),
-					typesystem.IgnoreMatching("Source"),
+					typesystem.IgnoreMatching("Source"), // implemented manually
 					typesystem.IgnoreMatching("TestLogMsg"),
 					typesystem.IgnoreMatching("String"),
 					typesystem.IgnoreMatching("Thread"),
@@ -131,7 +146,9 @@ var Main = genmain.Data{
 
 					typesystem.IgnoreMatching("HookFlagMask"), // Has a member of the same name
 
-					typesystem.IgnoreMatching("Variant"),           // TODO: implement manually
+					typesystem.IgnoreMatching("MainLoop.run"), // implemented manually with a context.Context
+
+					typesystem.IgnoreMatching("Variant"),           // implemented manually
 					typesystem.IgnoreMatching("variant_get_gtype"), // implemented with gvalue in gobject
 
 					typesystem.IgnoreMatching("ucs4_to_utf16"), // returns a pointer instead of an array
@@ -168,24 +185,21 @@ var Main = genmain.Data{
 			},
 			"Gio-2": {
 				ManualTypes: []typesystem.Type{
-					// TODO: implement a cancellable type that translates to context.Context
-					// &typesystem.Record{
-					// 	BaseType: typesystem.BaseType{
-					// 		GirName: "Cancellable",
-					// 		CGoTyp:  "C.GCancellable",
-					// 		CTyp:    "GCancellable",
-					// 		GoTyp:   "context.Context",
-
-					// 		GoImport: "context",
-					// 	},
-					// 	BaseConversions: typesystem.BaseConversions{
-					// 		FromGlibBorrowFunction: "",
-					// 		FromGlibFullFunction:   "",
-					// 		FromGlibNoneFunction:   "NewCancellableContext",
-					// 		ToGlibNoneFunction:     "UnsafeGCancellableToGlibNone",
-					// 		ToGlibFullFunction:     "",
-					// 	},
-					// },
+					&typesystem.Record{
+						BaseType: typesystem.BaseType{
+							GirName: "Cancellable",
+							CGoTyp:  "C.GCancellable",
+							CTyp:    "GCancellable",
+							GoTyp:   "context.Context",
+
+							GoImport: "context",
+						},
+						BaseConversions: typesystem.BaseConversions{
+							FromGlibNoneFunction:      "NewCancellableContext",
+							ToGlibNoneFunction:        "UnsafeGCancellableToGlibNone",
+							ToGlibNoneReleaseFunction: "UnsafeGCancellableRelease",
+						},
+					},
 				},
 				IgnoredDefinitions: []typesystem.IgnoreFunc{
 					// Nothing "Unix" is going to be available on Windows.
@@ -299,20 +313,19 @@ var Main = genmain.Data{
 					// manually implemented, but hidden from the user
 					typesystem.IgnoreMatching("ParamSpecClass"),
 					typesystem.IgnoreMatching("Closure"),
-					typesystem.IgnoreMatching("SignalGroup"),
 					typesystem.IgnoreMatching("SignalQuery"),
 					typesystem.IgnoreMatching("TypeQuery"),
+					typesystem.IgnoreMatching("BindingTransformFunc"), // go transform funcs are used instead of GValues
+
+					// the values are converted manually, use RegisterEnum and RegisterFlags in the register
+					// function of NewTypeModule instead
+					typesystem.IgnoreMatching("TypeModule.register_enum"),
+					typesystem.IgnoreMatching("TypeModule.register_flags"),
 
 					// maybe something for later, not needed now:
-					typesystem.IgnoreMatching("TypeModule"),
-					typesystem.IgnoreMatching("TypeModuleClass"),
 					typesystem.IgnoreMatching("ParamSpecPool"),
-					typesystem.IgnoreMatching("TypePlugin"),
-					typesystem.IgnoreMatching("TypePluginClass"),
 					typesystem.IgnoreMatching("ParamSpecTypeInfo"), // needed for registering param types
 
-					typesystem.IgnoreMatching("Binding"), // is this needed?
-
 					// signal handler accumulators, maybe implement them manually?
 					typesystem.IgnoreMatching("signal_accumulator_first_wins"),
 					typesystem.IgnoreMatching("signal_accumulator_true_handled"),
@@ -333,6 +346,28 @@ var Main = genmain.Data{
 	},
 }
 
+// Postprocessors defines a list of postprocessors that run on the resolved types before the
+// files are written.
+var Postprocessors = []typesystem.PostProcessor{
+	// handwritten methods that take go closures
+	typesystem.MarkAsManuallyExtended("GObject-2", "SignalGroup"),
+	typesystem.MarkAsManuallyExtended("GObject-2", "BindingGroup"),
+}
+
+// FixAsyncReadyCallbackClosure fixes GAsyncReadyCallback missing the closure bit for the user_data
+// parameter.
+var FixAsyncReadyCallbackClosure = gir.PreprocessorFunc(func(repos gir.Repositories) {
+	callback := repos.FindFullType("Gio-2.AsyncReadyCallback").(*gir.Callback)
+
+	userDataIx := slices.IndexFunc(
+		callback.Parameters.Parameters,
+		func(p *gir.Parameter) bool { return p.Name == "data" },
+	)
+
+	userData := callback.Parameters.Parameters[userDataIx]
+	userData.Closure = &userDataIx
+})
+
 // Preprocessors defines a list of preprocessors that the main generator will
 // use. It's mostly used for renaming colliding types/identifiers.
 var Preprocessors = []gir.Preprocessor{
@@ -344,19 +379,7 @@ var Preprocessors = []gir.Preprocessor{
 	gir.RemovePkgconfig("Gio-2.0.gir", "gio-unix-2.0"),
 	gir.RemoveCIncludes("Gio-2.0.gir", "gio/gfiledescriptorbased.h", `gio/gunix.*\.h`),
 
-	// Fix GAsyncReadyCallback missing the closure bit for the user_data
-	// parameter.
-	gir.PreprocessorFunc(func(repos gir.Repositories) {
-		callback := repos.FindFullType("Gio-2.AsyncReadyCallback").(*gir.Callback)
-
-		userDataIx := slices.IndexFunc(
-			callback.Parameters.Parameters,
-			func(p *gir.Parameter) bool { return p.Name == "data" },
-		)
-
-		userData := callback.Parameters.Parameters[userDataIx]
-		userData.Closure = &userDataIx
-	}),
+	FixAsyncReadyCallbackClosure,
 
 	// Collide in other namespaces (e.g. Gio) when implementing TypePlugin and TypeModule
 	gir.RenameCallable("GObject-2.TypePlugin.use", "use_plugin"),
@@ -370,4 +393,10 @@ var Preprocessors = []gir.Preprocessor{
 
 	// Less confusing because C.int differs from int in Go.
 	gir.RenameCallable("GObject-2.param_spec_int", "param_spec_int32"),
+
+	// g_param_spec_variant sinks a floating default value and refs it otherwise, the GIR claims
+	// that it takes the reference:
+	gir.ModifyCallable("GObject-2.param_spec_variant", func(c *gir.CallableAttrs) {
+		c.FindParameter("default_value").TransferOwnership.TransferOwnership = "none"
+	}),
 }
@@ -0,0 +1,267 @@
+package genmain_test
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-gst/go-glib/gir"
+	"github.com/go-gst/go-glib/gir/cmd/gir-generate/gendata"
+	"github.com/go-gst/go-glib/gir/cmd/gir-generate/genmain"
+)
+
+// gioAsync contains the GInputStream close and read_bytes async/finish pairs, the GAppInfo
+// launch_default_for_uri pair and the g_bus_get pair, as declared in Gio-2.0.gir.
+const gioAsync = `<?xml version="1.0"?>
+<repository xmlns="http://www.gtk.org/introspection/core/1.0" xmlns:c="http://www.gtk.org/introspection/c/1.0" xmlns:glib="http://www.gtk.org/introspection/glib/1.0" version="1.2">
+  <include name="GObject" version="2.0"/>
+  <include name="GLib" version="2.0"/>
+  <package name="gio-2.0"/>
+  <c:include name="gio/gio.h"/>
+  <namespace name="Gio" version="2.0" shared-library="libgio-2.0.so.0" c:identifier-prefixes="G" c:symbol-prefixes="g">
+    <callback name="AsyncReadyCallback" c:type="GAsyncReadyCallback">
+      <return-value transfer-ownership="none">
+        <type name="none" c:type="void"/>
+      </return-value>
+      <parameters>
+        <parameter name="source_object" transfer-ownership="none" nullable="1" allow-none="1">
+          <type name="GObject.Object" c:type="GObject*"/>
+        </parameter>
+        <parameter name="res" transfer-ownership="none">
+          <type name="AsyncResult" c:type="GAsyncResult*"/>
+        </parameter>
+        <parameter name="data" transfer-ownership="none" nullable="1" allow-none="1">
+          <type name="gpointer" c:type="gpointer"/>
+        </parameter>
+      </parameters>
+    </callback>
+    <enumeration name="BusType" version="2.26" glib:type-name="GBusType" glib:get-type="g_bus_type_get_type" c:type="GBusType">
+      <member name="starter" value="-1" c:identifier="G_BUS_TYPE_STARTER" glib:nick="starter" glib:name="G_BUS_TYPE_STARTER"/>
+      <member name="none" value="0" c:identifier="G_BUS_TYPE_NONE" glib:nick="none" glib:name="G_BUS_TYPE_NONE"/>
+      <member name="system" value="1" c:identifier="G_BUS_TYPE_SYSTEM" glib:nick="system" glib:name="G_BUS_TYPE_SYSTEM"/>
+      <member name="session" value="2" c:identifier="G_BUS_TYPE_SESSION" glib:nick="session" glib:name="G_BUS_TYPE_SESSION"/>
+    </enumeration>
+    <interface name="AsyncResult" c:symbol-prefix="async_result" c:type="GAsyncResult" glib:type-name="GAsyncResult" glib:get-type="g_async_result_get_type">
+    </interface>
+    <interface name="AppInfo" c:symbol-prefix="app_info" c:type="GAppInfo" glib:type-name="GAppInfo" glib:get-type="g_app_info_get_type">
+      <function name="launch_default_for_uri_async" c:identifier="g_app_info_launch_default_for_uri_async" version="2.50" glib:finish-func="launch_default_for_uri_finish">
+        <return-value transfer-ownership="none">
+          <type name="none" c:type="void"/>
+        </return-value>
+        <parameters>
+          <parameter name="uri" transfer-ownership="none">
+            <type name="utf8" c:type="const char*"/>
+          </parameter>
+          <parameter name="context" transfer-ownership="none" nullable="1" allow-none="1">
+            <type name="AppLaunchContext" c:type="GAppLaunchContext*"/>
+          </parameter>
+          <parameter name="cancellable" transfer-ownership="none" nullable="1" allow-none="1">
+            <type name="Cancellable" c:type="GCancellable*"/>
+          </parameter>
+          <parameter name="callback" transfer-ownership="none" nullable="1" allow-none="1" scope="async" closure="4">
+            <type name="AsyncReadyCallback" c:type="GAsyncReadyCallback"/>
+          </parameter>
+          <parameter name="user_data" transfer-ownership="none" nullable="1" allow-none="1">
+            <type name="gpointer" c:type="gpointer"/>
+          </parameter>
+        </parameters>
+      </function>
+      <function name="launch_default_for_uri_finish" c:identifier="g_app_info_launch_default_for_uri_finish" version="2.50" glib:async-func="launch_default_for_uri_async" throws="1">
+        <return-value transfer-ownership="none">
+          <type name="gboolean" c:type="gboolean"/>
+        </return-value>
+        <parameters>
+          <parameter name="result" transfer-ownership="none">
+            <type name="AsyncResult" c:type="GAsyncResult*"/>
+          </parameter>
+        </parameters>
+      </function>
+    </interface>
+    <class name="AppLaunchContext" c:symbol-prefix="app_launch_context" c:type="GAppLaunchContext" parent="GObject.Object" glib:type-name="GAppLaunchContext" glib:get-type="g_app_launch_context_get_type">
+    </class>
+    <class name="DBusConnection" c:symbol-prefix="dbus_connection" c:type="GDBusConnection" version="2.26" parent="GObject.Object" glib:type-name="GDBusConnection" glib:get-type="g_dbus_connection_get_type">
+    </class>
+    <class name="Cancellable" c:symbol-prefix="cancellable" c:type="GCancellable" parent="GObject.Object" glib:type-name="GCancellable" glib:get-type="g_cancellable_get_type">
+    </class>
+    <class name="InputStream" c:symbol-prefix="input_stream" c:type="GInputStream" parent="GObject.Object" abstract="1" glib:type-name="GInputStream" glib:get-type="g_input_stream_get_type">
+      <method name="close_async" c:identifier="g_input_stream_close_async" glib:finish-func="close_finish" glib:sync-func="close">
+        <return-value transfer-ownership="none">
+          <type name="none" c:type="void"/>
+        </return-value>
+        <parameters>
+          <instance-parameter name="stream" transfer-ownership="none">
+            <type name="InputStream" c:type="GInputStream*"/>
+          </instance-parameter>
+          <parameter name="io_priority" transfer-ownership="none">
+            <type name="gint" c:type="int"/>
+          </parameter>
+          <parameter name="cancellable" transfer-ownership="none" nullable="1" allow-none="1">
+            <type name="Cancellable" c:type="GCancellable*"/>
+          </parameter>
+          <parameter name="callback" transfer-ownership="none" nullable="1" allow-none="1" scope="async" closure="3">
+            <type name="AsyncReadyCallback" c:type="GAsyncReadyCallback"/>
+          </parameter>
+          <parameter name="user_data" transfer-ownership="none" nullable="1" allow-none="1" closure="3">
+            <type name="gpointer" c:type="gpointer"/>
+          </parameter>
+        </parameters>
+      </method>
+      <method name="close_finish" c:identifier="g_input_stream_close_finish" glib:async-func="close_async" throws="1">
+        <return-value transfer-ownership="none">
+          <type name="gboolean" c:type="gboolean"/>
+        </return-value>
+        <parameters>
+          <instance-parameter name="stream" transfer-ownership="none">
+            <type name="InputStream" c:type="GInputStream*"/>
+          </instance-parameter>
+          <parameter name="result" transfer-ownership="none">
+            <type name="AsyncResult" c:type="GAsyncResult*"/>
+          </parameter>
+        </parameters>
+      </method>
+      <method name="read_bytes_async" c:identifier="g_input_stream_read_bytes_async" glib:finish-func="read_bytes_finish" glib:sync-func="read_bytes">
+        <return-value transfer-ownership="none">
+          <type name="none" c:type="void"/>
+        </return-value>
+        <parameters>
+          <instance-parameter name="stream" transfer-ownership="none">
+            <type name="InputStream" c:type="GInputStream*"/>
+          </instance-parameter>
+          <parameter name="count" transfer-ownership="none">
+            <type name="gsize" c:type="gsize"/>
+          </parameter>
+          <parameter name="io_priority" transfer-ownership="none">
+            <type name="gint" c:type="int"/>
+          </parameter>
+          <parameter name="cancellable" transfer-ownership="none" nullable="1" allow-none="1">
+            <type name="Cancellable" c:type="GCancellable*"/>
+          </parameter>
+          <parameter name="callback" transfer-ownership="none" nullable="1" allow-none="1" scope="async" closure="4">
+            <type name="AsyncReadyCallback" c:type="GAsyncReadyCallback"/>
+          </parameter>
+          <parameter name="user_data" transfer-ownership="none" nullable="1" allow-none="1">
+            <type name="gpointer" c:type="gpointer"/>
+          </parameter>
+        </parameters>
+      </method>
+      <method name="read_bytes_finish" c:identifier="g_input_stream_read_bytes_finish" glib:async-func="read_bytes_async" throws="1">
+        <return-value transfer-ownership="full">
+          <type name="GLib.Bytes" c:type="GBytes*"/>
+        </return-value>
+        <parameters>
+          <instance-parameter name="stream" transfer-ownership="none">
+            <type name="InputStream" c:type="GInputStream*"/>
+          </instance-parameter>
+          <parameter name="result" transfer-ownership="none">
+            <type name="AsyncResult" c:type="GAsyncResult*"/>
+          </parameter>
+        </parameters>
+      </method>
+    </class>
+    <function name="bus_get" c:identifier="g_bus_get" version="2.26" glib:finish-func="bus_get_finish" glib:sync-func="bus_get_sync">
+      <return-value transfer-ownership="none">
+        <type name="none" c:type="void"/>
+      </return-value>
+      <parameters>
+        <parameter name="bus_type" transfer-ownership="none">
+          <type name="BusType" c:type="GBusType"/>
+        </parameter>
+        <parameter name="cancellable" transfer-ownership="none" nullable="1" allow-none="1">
+          <type name="Cancellable" c:type="GCancellable*"/>
+        </parameter>
+        <parameter name="callback" transfer-ownership="none" nullable="1" allow-none="1" scope="async" closure="3">
+          <type name="AsyncReadyCallback" c:type="GAsyncReadyCallback"/>
+        </parameter>
+        <parameter name="user_data" transfer-ownership="none" nullable="1" allow-none="1">
+          <type name="gpointer" c:type="gpointer"/>
+        </parameter>
+      </parameters>
+    </function>
+    <function name="bus_get_finish" c:identifier="g_bus_get_finish" version="2.26" glib:async-func="bus_get" throws="1">
+      <return-value transfer-ownership="full">
+        <type name="DBusConnection" c:type="GDBusConnection*"/>
+      </return-value>
+      <parameters>
+        <parameter name="res" transfer-ownership="none">
+          <type name="AsyncResult" c:type="GAsyncResult*"/>
+        </parameter>
+      </parameters>
+    </function>
+  </namespace>
+</repository>`
+
+// generateGio runs the generator with the given Gio GIR and returns the generated gio.gen.go.
+func generateGio(t *testing.T, gioGIR string) string {
+	t.Helper()
+
+	files := maps.Clone(gendata.Main.GirFiles)
+	files["Gio-2.0.gir"] = []byte(gioGIR)
+
+	d := gendata.Main
+	d.GirFiles = files
+
+	// the Gio ignores and the other preprocessors refer to Gio types that are not part of the test GIR
+	d.Config.Namespaces = maps.Clone(d.Config.Namespaces)
+	gio := d.Config.Namespaces["Gio-2"]
+	gio.IgnoredDefinitions = nil
+	d.Config.Namespaces["Gio-2"] = gio
+
+	d.Preprocessors = []gir.Preprocessor{
+		gir.TypeRenamer("GLib-2.file_test", "test_file"),
+		gendata.FixAsyncReadyCallbackClosure,
+		gir.RenameCallable("GObject-2.TypePlugin.use", "use_plugin"),
+		gir.RenameCallable("GObject-2.TypePlugin.unuse", "unuse_plugin"),
+		gir.RenameCallable("GObject-2.param_spec_int", "param_spec_int32"),
+	}
+
+	genmain.Output = t.TempDir()
+	genmain.Run(d)
+
+	gen, err := os.ReadFile(filepath.Join(genmain.Output, "gio", "v2", "gio.gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(gen)
+}
+
+var expectedAwait = []string{
+	"func (stream *InputStreamInstance) CloseAwait(cancellable context.Context, mainContext *glib.MainContext, ioPriority int32) (bool, error) {",
+	"_res, _err := glib.AwaitAsync(cancellable, mainContext, func(_done func(_asyncResult)) {",
+	"stream.CloseAsync(cancellable, ioPriority, func(_ gobject.Object, _result AsyncResult) {",
+	"_r.goret, _r._goerr = stream.CloseFinish(_result)",
+	"_res._goerr = _err",
+	"func (stream *InputStreamInstance) ReadBytesAwait(cancellable context.Context, mainContext *glib.MainContext, count uint, ioPriority int32) (*glib.Bytes, error) {",
+	"stream.ReadBytesAsync(cancellable, count, ioPriority, func(_ gobject.Object, _result AsyncResult) {",
+	"_r.goret, _r._goerr = stream.ReadBytesFinish(_result)",
+	"func BusGetAwait(cancellable context.Context, mainContext *glib.MainContext, busType BusType) (DBusConnection, error) {",
+	"BusGet(cancellable, busType, func(_ gobject.Object, _result AsyncResult) {",
+	"_r.goret, _r._goerr = BusGetFinish(_result)",
+	"func AppInfoLaunchDefaultForURIAwait(cancellable context.Context, mainContext *glib.MainContext, uri string, _context AppLaunchContext) (bool, error) {",
+	"AppInfoLaunchDefaultForURIAsync(cancellable, uri, _context, func(_ gobject.Object, _result AsyncResult) {",
+	"_r.goret, _r._goerr = AppInfoLaunchDefaultForURIFinish(_result)",
+}
+
+func TestGenerateAsyncAwait(t *testing.T) {
+	gen := generateGio(t, gioAsync)
+
+	for _, expected := range expectedAwait {
+		if !strings.Contains(gen, expected) {
+			t.Errorf("expected the generated code to contain %q", expected)
+		}
+	}
+}
+
+func TestGenerateAsyncAwaitFromAsyncFunc(t *testing.T) {
+	// pair the callables only by the glib:async-func attribute of the finish callables
+	gen := generateGio(t, regexp.MustCompile(` glib:finish-func="\w+"`).ReplaceAllString(gioAsync, ""))
+
+	for _, expected := range expectedAwait {
+		if !strings.Contains(gen, expected) {
+			t.Errorf("expected the generated code to contain %q", expected)
+		}
+	}
+}
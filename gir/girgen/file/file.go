@@ -3,6 +3,7 @@ package file
 import (
 	"fmt"
 	"io"
+	"path"
 
 	"github.com/go-gst/go-glib/gir/girgen/file/internal"
 	"github.com/go-gst/go-glib/gir/girgen/typesystem"
@@ -39,12 +40,13 @@ type file struct {
 
 var coreglibPkg = "github.com/go-gst/go-glib/pkg/core"
 
+// GoImportCore imports the given sub package of the core package, or the core package itself if pkg is empty.
 func (d *file) GoImportCore(pkg string) {
 	if d.goImports == nil {
 		d.goImports = make(goImports)
 	}
 
-	d.goImports.add(coreglibPkg+"/"+pkg, "", false)
+	d.goImports.add(path.Join(coreglibPkg, pkg), "", false)
 }
 
 // GoImportType imports either the namespace or the go package contained in the go type: FIXME: how to import nested packages?
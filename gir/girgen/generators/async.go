@@ -0,0 +1,266 @@
+package generators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-gst/go-glib/gir/girgen/file"
+	"github.com/go-gst/go-glib/gir/girgen/typesystem"
+)
+
+// AsyncMethodGenerator generates a blocking method or function for an async/finish pair. The generated
+// code calls the async callable on a main context, waits for the GAsyncReadyCallback and returns the results
+// of the finish callable.
+type AsyncMethodGenerator struct {
+	Doc SubGenerator
+
+	*typesystem.AsyncMethod
+}
+
+// goParameters returns the params of the blocking method, which are the params of the async method without
+// the callback and with the main context after the context.Context.
+func (g *AsyncMethodGenerator) goParameters() typesystem.ParamList {
+	var params typesystem.ParamList
+
+	for _, p := range g.Async.GoParameters {
+		if p == g.Callback {
+			continue
+		}
+
+		params = append(params, p)
+
+		if p == g.Context {
+			params = append(params, &typesystem.Param{
+				GoName:        "mainContext",
+				Type:          g.MainContext,
+				CTypePointers: 1,
+			})
+		}
+	}
+
+	return params
+}
+
+func (g *AsyncMethodGenerator) goReturns() string {
+	switch len(g.Finish.GoReturns) {
+	case 0:
+		return ""
+	case 1:
+		return " " + g.Finish.GoReturns[0].GoType()
+	default:
+		return " (" + g.Finish.GoReturns.GoTypes() + ")"
+	}
+}
+
+// callee returns the expression that calls the given callable, which is a method of the receiver of
+// the async method, or a function.
+func (g *AsyncMethodGenerator) callee(c *typesystem.CallableSignature) string {
+	if recv := g.Async.InstanceParam; recv != nil {
+		return recv.GoName + "." + c.GoIndentifier()
+	}
+
+	return c.GoIndentifier()
+}
+
+// Generate implements MethodGenerator.
+func (g *AsyncMethodGenerator) Generate(w *file.Package) {
+	g.Doc.Generate(w.Go())
+
+	source := asyncCallbackSourceParam(g.Callback)
+
+	w.GoImportType(g.MainContext)
+	w.GoImportType(source.Type)
+	w.GoImportType(g.Result.Type)
+
+	for _, p := range g.Async.GoParameters {
+		if p.Skip || p.Implicit {
+			continue
+		}
+		w.GoImportType(p.Type)
+	}
+
+	for _, ret := range g.Finish.GoReturns {
+		w.GoImportType(ret.Type)
+	}
+
+	if recv := g.Async.InstanceParam; recv != nil {
+		fmt.Fprintf(w.Go(), "func (%s *%s) %s(%s)%s {\n", recv.GoName, recv.Type.NamespacedGoType(0), g.GoName, g.goParameters().GoDeclarations(), g.goReturns())
+	} else {
+		fmt.Fprintf(w.Go(), "func %s(%s)%s {\n", g.GoName, g.goParameters().GoDeclarations(), g.goReturns())
+	}
+	w.Go().Indent()
+
+	fmt.Fprintln(w.Go(), "type _asyncResult struct {")
+	w.Go().Indent()
+	for _, ret := range g.Finish.GoReturns {
+		fmt.Fprintf(w.Go(), "%s %s\n", ret.GoName, ret.GoType())
+	}
+	w.Go().Unindent()
+	fmt.Fprintln(w.Go(), "}")
+	w.Go().NewSection()
+
+	var args []string
+
+	for _, p := range g.Async.GoParameters {
+		if p.Skip || p.Implicit {
+			continue
+		}
+
+		if p == g.Callback {
+			args = append(args, fmt.Sprintf("func(_ %s, _result %s) {", source.GoType(), g.Result.GoType()))
+			break
+		}
+
+		args = append(args, p.GoName)
+	}
+
+	fmt.Fprintf(w.Go(), "_res, _err := %s(%s, mainContext, func(_done func(_asyncResult)) {\n", g.MainContext.WithForeignNamespace("AwaitAsync"), g.Context.GoName)
+	w.Go().Indent()
+	fmt.Fprintf(w.Go(), "%s(%s\n", g.callee(g.Async), strings.Join(args, ", "))
+	w.Go().Indent()
+
+	var fields []string
+
+	for _, ret := range g.Finish.GoReturns {
+		fields = append(fields, "_r."+ret.GoName)
+	}
+
+	fmt.Fprintln(w.Go(), "var _r _asyncResult")
+	fmt.Fprintf(w.Go(), "%s = %s(_result)\n", strings.Join(fields, ", "), g.callee(g.Finish))
+	fmt.Fprintln(w.Go(), "_done(_r)")
+
+	w.Go().Unindent()
+	fmt.Fprintf(w.Go(), "}%s)\n", g.trailingArgs())
+	w.Go().Unindent()
+	fmt.Fprintln(w.Go(), "})")
+	w.Go().NewSection()
+
+	fmt.Fprintln(w.Go(), "if _err != nil {")
+	w.Go().Indent()
+	fmt.Fprintf(w.Go(), "_res.%s = _err\n", g.Error.GoName)
+	w.Go().Unindent()
+	fmt.Fprintln(w.Go(), "}")
+	w.Go().NewSection()
+
+	fields = fields[:0]
+
+	for _, ret := range g.Finish.GoReturns {
+		fields = append(fields, "_res."+ret.GoName)
+	}
+
+	fmt.Fprintf(w.Go(), "return %s\n", strings.Join(fields, ", "))
+
+	w.Go().Unindent()
+	fmt.Fprintf(w.Go(), "}\n\n")
+}
+
+// trailingArgs returns the arguments of the async method that come after the callback.
+func (g *AsyncMethodGenerator) trailingArgs() string {
+	var args []string
+	afterCallback := false
+
+	for _, p := range g.Async.GoParameters {
+		if p.Skip || p.Implicit {
+			continue
+		}
+
+		if p == g.Callback {
+			afterCallback = true
+			continue
+		}
+
+		if afterCallback {
+			args = append(args, p.GoName)
+		}
+	}
+
+	if len(args) == 0 {
+		return ""
+	}
+
+	return ", " + strings.Join(args, ", ")
+}
+
+// GenerateInterfaceSignature implements MethodGenerator.
+func (g *AsyncMethodGenerator) GenerateInterfaceSignature(w file.File) {
+	g.Doc.Generate(w.Go())
+
+	w.GoImportType(g.MainContext)
+
+	fmt.Fprintf(w.Go(), "%s(%s)%s\n", g.GoName, g.goParameters().GoTypes(), g.goReturns())
+}
+
+func NewAsyncMethodGenerator(cfg *Config, am *typesystem.AsyncMethod) *AsyncMethodGenerator {
+	return &AsyncMethodGenerator{
+		Doc: cfg.DocGenerator(am.Async).WithPrependParagraphs(fmt.Sprintf(
+			"%s calls %s on mainContext and blocks until %s returned or %s is done, which also cancels the operation.",
+			am.GoName, am.Async.GoIndentifier(), am.Finish.GoIndentifier(), am.Context.GoName,
+		)),
+		AsyncMethod: am,
+	}
+}
+
+// newAsyncMethodGenerators creates the blocking method generators for the given async methods. Methods
+// whose names are already taken in the type hierarchy are skipped.
+func newAsyncMethodGenerators(cfg *Config, asyncMethods []*typesystem.AsyncMethod, taken map[string]bool) MethodGeneratorList {
+	var gens MethodGeneratorList
+
+	for _, am := range asyncMethods {
+		if taken[am.GoName] {
+			continue
+		}
+
+		if asyncCallbackSourceParam(am.Callback) == nil {
+			continue
+		}
+
+		taken[am.GoName] = true
+		gens = append(gens, NewAsyncMethodGenerator(cfg, am))
+	}
+
+	return gens
+}
+
+// newAsyncFunctionGenerators creates the blocking function generators for the given async functions.
+// Functions whose names are already taken by the given functions are skipped.
+func newAsyncFunctionGenerators(cfg *Config, asyncFunctions []*typesystem.AsyncMethod, functions []*typesystem.CallableSignature) GeneratorList {
+	taken := make(map[string]bool, len(functions))
+
+	for _, fn := range functions {
+		taken[fn.GoIndentifier()] = true
+	}
+
+	var gens GeneratorList
+
+	for _, gen := range newAsyncMethodGenerators(cfg, asyncFunctions, taken) {
+		gens = append(gens, gen)
+	}
+
+	return gens
+}
+
+// asyncCallbackSourceParam returns the source object param of the GAsyncReadyCallback, or nil if the
+// callback doesn't have the expected (source, result) signature.
+func asyncCallbackSourceParam(p *typesystem.Param) *typesystem.Param {
+	cb, ok := p.Type.Type.(*typesystem.Callback)
+
+	if !ok {
+		return nil
+	}
+
+	var params typesystem.ParamList
+
+	for _, p := range cb.GoParameters {
+		if p.Skip || p.Implicit || p.IsUserData {
+			continue
+		}
+
+		params = append(params, p)
+	}
+
+	if len(params) != 2 {
+		return nil
+	}
+
+	return params[0]
+}
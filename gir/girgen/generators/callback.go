@@ -68,6 +68,11 @@ func (c *CallbackGenerator) generateExport(pkg *file.Package) {
 
 	w.Go().Indent()
 
+	w.GoImportCore("")
+	fmt.Fprintf(w.Go(), "defer core.RecoverCallback(\"%s\")\n", c.TrampolineName)
+
+	w.Go().NewSection()
+
 	fmt.Fprintf(w.Go(), "var fn %s\n", c.GoType(0)) // declare fn as the callback itself
 
 	w.GoImport("unsafe")
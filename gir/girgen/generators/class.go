@@ -229,6 +229,8 @@ func NewClassGenerator(cfg *Config, c *typesystem.Class) *ClassGenerator {
 		g.SubGenerators = append(g.SubGenerators, NewCallableGenerator(cfg, fn))
 	}
 
+	g.SubGenerators = append(g.SubGenerators, newAsyncFunctionGenerators(cfg, c.AsyncFunctions, c.Functions)...)
+
 	for _, method := range c.Methods {
 		g.Methods = append(g.Methods, NewCallableGenerator(cfg, method))
 	}
@@ -242,6 +244,11 @@ func NewClassGenerator(cfg *Config, c *typesystem.Class) *ClassGenerator {
 		}
 	}
 
+	taken := classTakenMethodNames(c)
+
+	g.Methods = append(g.Methods, newAsyncMethodGenerators(cfg, c.AsyncMethods, taken)...)
+	g.Methods = append(g.Methods, newPropertyGenerators(cfg, c.Properties, taken)...)
+
 	return g
 }
 
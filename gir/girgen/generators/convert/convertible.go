@@ -39,6 +39,9 @@ var _ Converter = (*CToGoConvertibleConverter)(nil)
 type GoToCConvertibleConverter struct {
 	Param       *typesystem.Param
 	ConvertFunc string
+
+	// ReleaseFunc is deferred with the converted value if set
+	ReleaseFunc string
 }
 
 // Convert implements Converter.
@@ -50,6 +53,12 @@ func (c *GoToCConvertibleConverter) Convert(w file.File) {
 	}
 
 	fmt.Fprintf(w.Go(), "%s = (%s)(%s(%s))\n", cname, c.Param.CGoType(), c.ConvertFunc, c.Param.GoName)
+
+	if c.ReleaseFunc != "" && c.Param.Direction != "out" {
+		w.GoImport("unsafe")
+
+		fmt.Fprintf(w.Go(), "defer %s(unsafe.Pointer(%s))\n", c.ReleaseFunc, c.Param.CName)
+	}
 }
 
 // Metadata implements Converter.
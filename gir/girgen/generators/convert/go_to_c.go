@@ -76,9 +76,16 @@ func newGoToCBasicConverter(p *typesystem.Param) Converter {
 	if ok && p.CTypePointers == 1 {
 
 		if ok && conv.CanTransferToGlib(p.TransferOwnership) {
+			var release string
+
+			if p.TransferOwnership == typesystem.TransferNone && conv.GoUnsafeToGlibNoneReleaseFunction() != "" {
+				release = p.Type.WithForeignNamespace(conv.GoUnsafeToGlibNoneReleaseFunction())
+			}
+
 			return &GoToCConvertibleConverter{
 				Param:       p,
 				ConvertFunc: p.Type.WithForeignNamespace(conv.GetTransferToGlibFunction(p.TransferOwnership)),
+				ReleaseFunc: release,
 			}
 		}
 	}
@@ -170,6 +170,10 @@ func mkGtkDocURL(namespace *typesystem.Namespace, documented typesystem.Document
 		parent := d.InstanceParam.Type.Type.GIRName()
 
 		docPath = "signal." + parent + "." + d.Name + ".html"
+	case *typesystem.Property:
+		parent := d.Parent.GIRName()
+
+		docPath = "property." + parent + ":" + d.Name + ".html"
 	default:
 		fmt.Printf("unhandled type %T for GTK doc URL generation\n", d)
 		panic("unsupported documented type for GTK doc URL generation")
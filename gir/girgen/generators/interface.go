@@ -166,6 +166,8 @@ func NewInterfaceGenerator(cfg *Config, c *typesystem.Interface) *InterfaceGener
 		}
 	}
 
+	g.SubGenerators = append(g.SubGenerators, newAsyncFunctionGenerators(cfg, c.AsyncFunctions, c.Functions)...)
+
 	for _, method := range c.Methods {
 		if methGen := NewCallableGenerator(cfg, method); methGen != nil {
 			g.Methods = append(g.Methods, methGen)
@@ -181,6 +183,11 @@ func NewInterfaceGenerator(cfg *Config, c *typesystem.Interface) *InterfaceGener
 		}
 	}
 
+	taken := interfaceTakenMethodNames(c)
+
+	g.Methods = append(g.Methods, newAsyncMethodGenerators(cfg, c.AsyncMethods, taken)...)
+	g.Methods = append(g.Methods, newPropertyGenerators(cfg, c.Properties, taken)...)
+
 	return g
 }
 
@@ -61,6 +61,7 @@ func NewNamespaceGenerator(
 			gen.SubGenerators = append(gen.SubGenerators, fgen)
 		}
 	}
+	gen.SubGenerators = append(gen.SubGenerators, newAsyncFunctionGenerators(cfg, ns.AsyncFunctions, ns.Functions)...)
 	for _, inter := range ns.Interfaces {
 		if intergen := NewInterfaceGenerator(cfg, inter); intergen != nil {
 			gen.SubGenerators = append(gen.SubGenerators, intergen)
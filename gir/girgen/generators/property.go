@@ -0,0 +1,224 @@
+package generators
+
+import (
+	"fmt"
+
+	"github.com/go-gst/go-glib/gir/girgen/file"
+	"github.com/go-gst/go-glib/gir/girgen/typesystem"
+)
+
+// reservedObjectMethodNames contains the methods of the manually implemented gobject.Object that
+// could collide with generated property accessors.
+var reservedObjectMethodNames = []string{
+	"GetProperty",
+	"SetProperty",
+	"SetObjectProperty",
+	"SetObjectProperties",
+	"SetGoValue",
+	"NotifyProperty",
+}
+
+type PropertyGetterGenerator struct {
+	Doc SubGenerator
+
+	*typesystem.Property
+}
+
+// Generate implements MethodGenerator.
+func (p *PropertyGetterGenerator) Generate(w *file.Package) {
+	p.Doc.Generate(w.Go())
+
+	w.GoImportType(p.Value.Type)
+	w.GoImportType(p.GObject)
+
+	fmt.Fprintf(w.Go(), "func (o *%s) %s() %s {\n", p.Parent.GoType(0), p.GoGetterName, p.Value.GoType())
+	w.Go().Indent()
+	fmt.Fprintf(w.Go(), "return %s[%s](%s, \"%s\")\n", p.GObject.WithForeignNamespace("ObjectPropertyAs"), p.Value.GoType(), propertyObjectArgument(p.Property), p.Name)
+	w.Go().Unindent()
+	fmt.Fprintln(w.Go(), "}")
+	fmt.Fprintln(w.Go(), "")
+}
+
+// GenerateInterfaceSignature implements MethodGenerator.
+func (p *PropertyGetterGenerator) GenerateInterfaceSignature(w file.File) {
+	p.Doc.Generate(w.Go())
+
+	fmt.Fprintf(w.Go(), "%s() %s\n", p.GoGetterName, p.Value.GoType())
+}
+
+func NewPropertyGetterGenerator(cfg *Config, prop *typesystem.Property) *PropertyGetterGenerator {
+	return &PropertyGetterGenerator{
+		Doc:      cfg.DocGenerator(prop).WithPrependParagraphs(fmt.Sprintf("%s gets the \"%s\" property", prop.GoGetterName, prop.Name)),
+		Property: prop,
+	}
+}
+
+type PropertySetterGenerator struct {
+	Doc SubGenerator
+
+	*typesystem.Property
+}
+
+// Generate implements MethodGenerator.
+func (p *PropertySetterGenerator) Generate(w *file.Package) {
+	p.Doc.Generate(w.Go())
+
+	w.GoImportType(p.Value.Type)
+
+	fmt.Fprintf(w.Go(), "func (o *%s) %s(value %s) {\n", p.Parent.GoType(0), p.GoSetterName, p.Value.GoType())
+	w.Go().Indent()
+	fmt.Fprintf(w.Go(), "%s.SetObjectProperty(\"%s\", value)\n", propertyObjectIdentifier(p.Property), p.Name)
+	w.Go().Unindent()
+	fmt.Fprintln(w.Go(), "}")
+	fmt.Fprintln(w.Go(), "")
+}
+
+// GenerateInterfaceSignature implements MethodGenerator.
+func (p *PropertySetterGenerator) GenerateInterfaceSignature(w file.File) {
+	p.Doc.Generate(w.Go())
+
+	fmt.Fprintf(w.Go(), "%s(%s)\n", p.GoSetterName, p.Value.GoType())
+}
+
+func NewPropertySetterGenerator(cfg *Config, prop *typesystem.Property) *PropertySetterGenerator {
+	return &PropertySetterGenerator{
+		Doc:      cfg.DocGenerator(prop).WithPrependParagraphs(fmt.Sprintf("%s sets the \"%s\" property", prop.GoSetterName, prop.Name)),
+		Property: prop,
+	}
+}
+
+type PropertyNotifyGenerator struct {
+	Doc SubGenerator
+
+	*typesystem.Property
+}
+
+// Generate implements MethodGenerator.
+func (p *PropertyNotifyGenerator) Generate(w *file.Package) {
+	p.Doc.Generate(w.Go())
+
+	w.GoImportType(p.GObject)
+
+	fmt.Fprintf(w.Go(), "func (o *%s) %s(fn func()) %s {\n", p.Parent.GoType(0), p.GoNotifyName, p.GObject.WithForeignNamespace("SignalHandle"))
+	w.Go().Indent()
+	fmt.Fprintf(w.Go(), "return %s.NotifyProperty(\"%s\", func(%s, *%s) {\n", propertyObjectIdentifier(p.Property), p.Name, p.GObject.NamespacedGoType(1), p.GObject.WithForeignNamespace("ParamSpec"))
+	w.Go().Indent()
+	fmt.Fprintln(w.Go(), "fn()")
+	w.Go().Unindent()
+	fmt.Fprintln(w.Go(), "})")
+	w.Go().Unindent()
+	fmt.Fprintln(w.Go(), "}")
+	fmt.Fprintln(w.Go(), "")
+}
+
+// GenerateInterfaceSignature implements MethodGenerator.
+func (p *PropertyNotifyGenerator) GenerateInterfaceSignature(w file.File) {
+	p.Doc.Generate(w.Go())
+
+	fmt.Fprintf(w.Go(), "%s(func()) %s\n", p.GoNotifyName, p.GObject.WithForeignNamespace("SignalHandle"))
+}
+
+func NewPropertyNotifyGenerator(cfg *Config, prop *typesystem.Property) *PropertyNotifyGenerator {
+	return &PropertyNotifyGenerator{
+		Doc:      cfg.DocGenerator(prop).WithPrependParagraphs(fmt.Sprintf("%s connects the provided callback to change notifications of the \"%s\" property", prop.GoNotifyName, prop.Name)),
+		Property: prop,
+	}
+}
+
+// propertyObjectIdentifier returns the struct field that contains the gobject. This is only needed for interfaces.
+func propertyObjectIdentifier(p *typesystem.Property) string {
+	if _, ok := p.Parent.(*typesystem.Interface); ok {
+		return fmt.Sprintf("o.%s", InterfaceInstanceStructFieldName)
+	}
+
+	return "o"
+}
+
+// propertyObjectArgument returns the expression that passes the gobject as an Object.
+func propertyObjectArgument(p *typesystem.Property) string {
+	if _, ok := p.Parent.(*typesystem.Interface); ok {
+		return "&" + propertyObjectIdentifier(p)
+	}
+
+	return "o"
+}
+
+// newPropertyGenerators creates the accessor generators for the given properties. Accessors whose names
+// are already taken by a method in the type hierarchy are skipped, this is the case for properties that
+// come with a C getter or setter.
+func newPropertyGenerators(cfg *Config, props []*typesystem.Property, taken map[string]bool) MethodGeneratorList {
+	var gens MethodGeneratorList
+
+	for _, name := range reservedObjectMethodNames {
+		taken[name] = true
+	}
+
+	for _, prop := range props {
+		if prop.HasGetter() && !taken[prop.GoGetterName] {
+			taken[prop.GoGetterName] = true
+			gens = append(gens, NewPropertyGetterGenerator(cfg, prop))
+		}
+
+		if prop.HasSetter() && !taken[prop.GoSetterName] {
+			taken[prop.GoSetterName] = true
+			gens = append(gens, NewPropertySetterGenerator(cfg, prop))
+		}
+
+		if prop.HasNotify() && !taken[prop.GoNotifyName] {
+			taken[prop.GoNotifyName] = true
+			gens = append(gens, NewPropertyNotifyGenerator(cfg, prop))
+		}
+	}
+
+	return gens
+}
+
+// addTakenMethodNames adds the go names of all generated methods of the type to taken.
+func addTakenMethodNames(taken map[string]bool, methods []*typesystem.CallableSignature, signals []*typesystem.Signal, props []*typesystem.Property, asyncMethods []*typesystem.AsyncMethod) {
+	for _, m := range methods {
+		taken[m.GoIndentifier()] = true
+	}
+
+	for _, s := range signals {
+		taken[s.GoName] = true
+	}
+
+	for _, p := range props {
+		taken[p.GoGetterName] = true
+		taken[p.GoSetterName] = true
+		taken[p.GoNotifyName] = true
+	}
+
+	for _, am := range asyncMethods {
+		taken[am.GoName] = true
+	}
+}
+
+// classTakenMethodNames returns the method names of the class, its parents and all implemented interfaces.
+// Properties and async methods of the class itself are not included, because they are the ones being generated.
+func classTakenMethodNames(c *typesystem.Class) map[string]bool {
+	taken := make(map[string]bool)
+
+	addTakenMethodNames(taken, c.Methods, c.Signals, nil, nil)
+
+	for cur := c; cur != nil; cur = cur.Parent.Type {
+		if cur != c {
+			addTakenMethodNames(taken, cur.Methods, cur.Signals, cur.Properties, cur.AsyncMethods)
+		}
+
+		for _, inter := range cur.Implements {
+			addTakenMethodNames(taken, inter.Type.Methods, inter.Type.Signals, inter.Type.Properties, inter.Type.AsyncMethods)
+		}
+	}
+
+	return taken
+}
+
+// interfaceTakenMethodNames returns the method names of the interface.
+func interfaceTakenMethodNames(in *typesystem.Interface) map[string]bool {
+	taken := make(map[string]bool)
+
+	addTakenMethodNames(taken, in.Methods, in.Signals, nil, nil)
+
+	return taken
+}
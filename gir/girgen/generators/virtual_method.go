@@ -228,6 +228,11 @@ func (c *VirtualMethodGenerator) generateExport(pkg *file.Package) {
 
 	w.Go().Indent()
 
+	w.GoImportCore("")
+	fmt.Fprintf(w.Go(), "defer core.RecoverCallback(\"%s\")\n", c.TrampolineName)
+
+	w.Go().NewSection()
+
 	fmt.Fprintf(w.Go(), "var fn func%s\n", c.CGoTrampolineTail())
 	fmt.Fprintf(w.Go(), "{\n")
 	w.Go().Indent()
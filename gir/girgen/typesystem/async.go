@@ -0,0 +1,144 @@
+package typesystem
+
+import "strings"
+
+// AsyncMethod pairs an asynchronous method or function that reports its completion through a
+// GAsyncReadyCallback with the callable that finishes it. The pairs are declared from the glib:finish-func
+// and glib:async-func attributes and are used to generate a blocking variant that returns the results of
+// the finish callable.
+type AsyncMethod struct {
+	// GoName is the name of the generated blocking method or function
+	GoName string
+
+	Async  *CallableSignature
+	Finish *CallableSignature
+
+	// Context is the context.Context param of Async that replaces the GCancellable
+	Context *Param
+	// Callback is the GAsyncReadyCallback param of Async
+	Callback *Param
+	// Result is the GAsyncResult param of Finish
+	Result *Param
+	// Error is the error return of Finish, which also reports that the context was done
+	Error *Param
+
+	// MainContext is the (foreign) GLib.MainContext type that the operation is dispatched on
+	MainContext CouldBeForeign[Type]
+}
+
+// NewAsyncMethods pairs the given methods or functions by their glib:finish-func attribute, or by the
+// glib:async-func attribute of the finish callable if the async callable doesn't name it. Pairs that
+// can't be expressed as a blocking call are skipped.
+func NewAsyncMethods(e *env, methods []*CallableSignature) []*AsyncMethod {
+	byName := make(map[string]*CallableSignature, len(methods))
+	finishes := make(map[string]*CallableSignature)
+
+	for _, m := range methods {
+		byName[m.Girname] = m
+	}
+
+	for _, m := range methods {
+		// the sync variant also names the async callable, but only the finish callable is named after it
+		if m.AsyncFunc != "" && strings.HasSuffix(m.Girname, "_finish") {
+			finishes[m.AsyncFunc] = m
+		}
+	}
+
+	var asyncMethods []*AsyncMethod
+
+	for _, m := range methods {
+		var finish *CallableSignature
+
+		switch {
+		case m.FinishFunc != "":
+			finish = byName[m.FinishFunc]
+		case finishes[m.Girname] != nil:
+			finish = finishes[m.Girname]
+		default:
+			continue
+		}
+
+		if am := newAsyncMethod(e.sub("async", m.GirCIdentifier), m, finish); am != nil {
+			asyncMethods = append(asyncMethods, am)
+		}
+	}
+
+	return asyncMethods
+}
+
+func newAsyncMethod(e *env, async, finish *CallableSignature) *AsyncMethod {
+	if finish == nil {
+		e.logger.Info("skipping blocking variant because the finish method was not generated", "finish", async.FinishFunc)
+		return nil
+	}
+
+	if len(async.GoReturns) != 0 {
+		e.logger.Info("skipping blocking variant because the async method returns values")
+		return nil
+	}
+
+	am := &AsyncMethod{
+		GoName: strings.TrimSuffix(async.GoIndentifier(), "Async") + "Await",
+		Async:  async,
+		Finish: finish,
+	}
+
+	for _, p := range async.GoParameters {
+		switch {
+		case p.Implicit || p.Skip:
+		case p.Type.Type.GoType(0) == "context.Context":
+			am.Context = p
+		case p.Type.Type.GIRName() == "AsyncReadyCallback" && p.Closure != nil:
+			am.Callback = p
+		}
+	}
+
+	if am.Context == nil || am.Callback == nil {
+		e.logger.Info("skipping blocking variant because the async method is not cancellable")
+		return nil
+	}
+
+	for _, p := range finish.GoParameters {
+		if p.Implicit || p.Skip {
+			continue
+		}
+
+		if am.Result != nil || p.Type.Type.GIRName() != "AsyncResult" {
+			e.logger.Info("skipping blocking variant because the finish method takes more than the result")
+			return nil
+		}
+
+		am.Result = p
+	}
+
+	if am.Result == nil {
+		e.logger.Info("skipping blocking variant because the finish method does not take a result")
+		return nil
+	}
+
+	for _, ret := range finish.GoReturns {
+		if ret.Type.Type.GoType(0) == "error" {
+			am.Error = ret
+		}
+	}
+
+	if am.Error == nil {
+		// the blocking variant stops waiting when the context is done, which must be reported
+		e.logger.Info("skipping blocking variant because the finish method does not throw")
+		return nil
+	}
+
+	ns, mainContext := e.findTypeByGIRName("GLib.MainContext")
+
+	if mainContext == nil {
+		e.logger.Warn("GLib.MainContext not found")
+		return nil
+	}
+
+	am.MainContext = CouldBeForeign[Type]{
+		Namespace: ns,
+		Type:      mainContext,
+	}
+
+	return am
+}
@@ -86,6 +86,12 @@ type CallableSignature struct {
 
 	// Parent is the parent type
 	Parent Type
+
+	// FinishFunc is the GIR name of the callable that finishes this asynchronous callable
+	FinishFunc string
+	// AsyncFunc is the GIR name of the asynchronous callable that this callable finishes or
+	// runs synchronously
+	AsyncFunc string
 }
 
 func DeclareFunction(e *env, v *gir.CallableAttrs) *CallableSignature {
@@ -124,6 +130,8 @@ func DeclareFunction(e *env, v *gir.CallableAttrs) *CallableSignature {
 			GirCIdentifier:  v.CIdentifier,
 		},
 		Parameters: params,
+		FinishFunc: v.GLibFinishFunc,
+		AsyncFunc:  v.GLibAsyncFunc,
 	}
 }
 
@@ -164,6 +172,8 @@ func DeclarePrefixedFunction(e *env, parent Type, v *gir.CallableAttrs) *Callabl
 		},
 		Parameters: params,
 		Parent:     parent,
+		FinishFunc: v.GLibFinishFunc,
+		AsyncFunc:  v.GLibAsyncFunc,
 	}
 }
 
@@ -205,5 +215,7 @@ func DeclareMethod(e *env, parent Type, v *gir.Method) *CallableSignature {
 		},
 		Parameters: params,
 		Parent:     parent,
+		FinishFunc: v.GLibFinishFunc,
+		AsyncFunc:  v.GLibAsyncFunc,
 	}
 }
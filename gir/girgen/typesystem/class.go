@@ -62,6 +62,9 @@ type Class struct {
 	Constructors   []*CallableSignature
 	Fields         []*Field
 	Signals        []*Signal
+	Properties     []*Property
+	AsyncMethods   []*AsyncMethod
+	AsyncFunctions []*AsyncMethod
 
 	// ManuallyExtended is true if the class is manually extended by the user
 	// this will embed an extra (not generated) interface with the naming scheme `<GoInterfaceName>ExtManual` in the classes interface.
@@ -293,12 +296,21 @@ func (c *Class) declareNested(e *env) {
 		}
 	}
 
+	c.AsyncMethods = NewAsyncMethods(e, c.Methods)
+	c.AsyncFunctions = NewAsyncMethods(e, c.Functions)
+
 	for _, v := range c.gir.Signals {
 		if t := NewSignal(e, c, v); t != nil {
 			c.Signals = append(c.Signals, t)
 		}
 	}
 
+	for _, v := range c.gir.Properties {
+		if t := NewProperty(e, c, v); t != nil {
+			c.Properties = append(c.Properties, t)
+		}
+	}
+
 	for _, v := range c.gir.Fields {
 		if t := NewField(e, c, v); t != nil {
 			c.Fields = append(c.Fields, t)
@@ -41,6 +41,9 @@ type Interface struct {
 	Methods        []*CallableSignature
 	VirtualMethods []*VirtualMethod
 	Signals        []*Signal
+	Properties     []*Property
+	AsyncMethods   []*AsyncMethod
+	AsyncFunctions []*AsyncMethod
 
 	// ManuallyExtended is true if the class is manually extended by the user
 	// this will embed an extra (not generated) interface with the naming scheme `<GoInterfaceName>ExtManual` in the classes interface.
@@ -199,12 +202,21 @@ func (in *Interface) declareNested(e *env) {
 		}
 	}
 
+	in.AsyncMethods = NewAsyncMethods(e, in.Methods)
+	in.AsyncFunctions = NewAsyncMethods(e, in.Functions)
+
 	for _, v := range in.gir.Signals {
 		if t := NewSignal(e, in, v); t != nil {
 			in.Signals = append(in.Signals, t)
 		}
 	}
 
+	for _, v := range in.gir.Properties {
+		if t := NewProperty(e, in, v); t != nil {
+			in.Properties = append(in.Properties, t)
+		}
+	}
+
 	if in.TypeStruct != nil {
 		for _, v := range in.gir.VirtualMethods {
 			if t := NewVirtualMethod(e, in, in.TypeStruct, v); t != nil {
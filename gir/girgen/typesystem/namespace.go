@@ -38,8 +38,9 @@ type Namespace struct {
 	Unions     []*Union
 
 	// identifiers, these are eagerly resolved
-	Constants []*Constant
-	Functions []*CallableSignature
+	Constants      []*Constant
+	Functions      []*CallableSignature
+	AsyncFunctions []*AsyncMethod
 }
 
 func (reg *Registry) newNamespace(cfg Config, ns *namespaceWithIncludes) *Namespace {
@@ -165,6 +166,7 @@ func (reg *Registry) newNamespace(cfg Config, ns *namespaceWithIncludes) *Namesp
 			namespace.Functions = append(namespace.Functions, t)
 		}
 	}
+	namespace.AsyncFunctions = NewAsyncMethods(e, namespace.Functions)
 	for _, v := range ns.Constants {
 		if t := DeclareConstant(e, v); t != nil {
 			namespace.Constants = append(namespace.Constants, t)
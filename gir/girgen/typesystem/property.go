@@ -0,0 +1,152 @@
+package typesystem
+
+import (
+	"github.com/go-gst/go-glib/gir"
+	"github.com/go-gst/go-glib/gir/girgen/strcases"
+)
+
+// Property describes a GObject property of a class or interface. The generators use it to
+// create typed getters, setters and notify connectors that call into the gobject property API.
+type Property struct {
+	Doc
+	Name string
+
+	// GoGetterName, GoSetterName and GoNotifyName are the names of the generated methods.
+	GoGetterName string
+	GoSetterName string
+	GoNotifyName string
+
+	Readable      bool
+	Writable      bool
+	Construct     bool
+	ConstructOnly bool
+
+	// GObject is used to resolve the gobject namespace for the property helpers.
+	GObject CouldBeForeign[Type]
+
+	// Parent is the class or interface that declares the property
+	Parent Type
+
+	// Value is the type of the property value
+	Value *Param
+}
+
+func NewProperty(e *env, parent Type, v *gir.Property) *Property {
+	e = e.sub("property", v.Name)
+
+	if !v.IsIntrospectable() {
+		e.logger.Warn("skipping because not introspectable")
+		return nil
+	}
+
+	if e.skip(parent, v) {
+		return nil
+	}
+
+	ns, obj := e.findTypeByGIRName("GObject.Object")
+
+	if obj == nil {
+		e.logger.Warn("GObject.Object not found")
+		return nil
+	}
+
+	if v.AnyType.Type == nil {
+		e.logger.Info("skipping property because it is not a simple type")
+		return nil
+	}
+
+	typns, t := e.findAnyType(v.AnyType)
+
+	if t == nil {
+		e.logger.Warn("type not found", "type", debugCTypeFromAnytype(v.AnyType))
+		return nil
+	}
+
+	if !propertyTypeSupported(t) {
+		e.logger.Info("skipping property because the type cannot be converted through a GValue", "type", t.GIRName())
+		return nil
+	}
+
+	pointers := CountCTypePointers(CTypeFromAnytype(v.AnyType))
+
+	if pointers == 0 {
+		if res, ok := t.(minPointerConstrainedType); ok {
+			pointers = res.minPointersRequired()
+		}
+	}
+
+	if res, ok := t.(maxPointerConstrainedType); ok {
+		pointers = min(pointers, res.maxPointersAllowed())
+	}
+
+	goName := strcases.KebabToGo(true, v.Name)
+
+	return &Property{
+		Doc:           NewDoc(&v.InfoAttrs, &v.InfoElements),
+		Name:          v.Name,
+		GoGetterName:  "Get" + goName,
+		GoSetterName:  "Set" + goName,
+		GoNotifyName:  "Notify" + goName,
+		Readable:      v.IsReadable(),
+		Writable:      v.Writable,
+		Construct:     v.Construct,
+		ConstructOnly: v.ConstructOnly,
+
+		GObject: CouldBeForeign[Type]{
+			Namespace: ns,
+			Type:      obj,
+		},
+		Parent: parent,
+
+		Value: &Param{
+			Doc:    NewParamDoc(gir.ParameterAttrs{}),
+			CName:  "// invalid identifier",
+			GoName: "value",
+			Type: CouldBeForeign[Type]{
+				Namespace: typns,
+				Type:      t,
+			},
+			CTypePointers: pointers,
+		},
+	}
+}
+
+// propertyTypeSupported returns true if values of the type are marshaled to and from GValues
+// with the same go type that the generated code uses.
+func propertyTypeSupported(t Type) bool {
+	switch t := t.(type) {
+	case *BooleanPrimitive, *StringPrimitive, *Class, *Interface:
+		return true
+	case *CastablePrimitive:
+		switch t.GoType(0) {
+		case "unsafe.Pointer", "uintptr":
+			return false
+		}
+		return true
+	case *Enum:
+		return t.CanMarshal()
+	case *Bitfield:
+		return t.CanMarshal()
+	case *Record:
+		return t.CanMarshal()
+	}
+
+	return false
+}
+
+// HasGetter returns true if a typed getter should be generated.
+func (p *Property) HasGetter() bool {
+	return p.Readable
+}
+
+// HasSetter returns true if a typed setter should be generated. Construct only properties
+// can only be set when creating the object, so they don't get a setter.
+func (p *Property) HasSetter() bool {
+	return p.Writable && !p.ConstructOnly
+}
+
+// HasNotify returns true if a notify connector should be generated. Construct only properties
+// never change after construction, so they don't get one.
+func (p *Property) HasNotify() bool {
+	return !p.ConstructOnly
+}
@@ -16,6 +16,10 @@ type ConvertibleType interface {
 
 	GoUnsafeToGlibFullFunction() string
 	GoUnsafeToGlibNoneFunction() string
+
+	// GoUnsafeToGlibNoneReleaseFunction returns the function that must be deferred to release the
+	// value returned by the transfer none conversion, or an empty string if nothing needs to be released.
+	GoUnsafeToGlibNoneReleaseFunction() string
 }
 
 type BaseConversions struct {
@@ -24,6 +28,10 @@ type BaseConversions struct {
 	FromGlibNoneFunction   string
 	ToGlibNoneFunction     string
 	ToGlibFullFunction     string
+
+	// ToGlibNoneReleaseFunction is optional and is deferred after the ToGlibNoneFunction conversion
+	// for types that create a temporary C value, e.g. a GCancellable for a context.Context
+	ToGlibNoneReleaseFunction string
 }
 
 // CanTransferFromGlib implements ConvertibleType.
@@ -113,3 +121,8 @@ func (b BaseConversions) GoUnsafeToGlibFullFunction() string {
 func (b BaseConversions) GoUnsafeToGlibNoneFunction() string {
 	return b.ToGlibNoneFunction
 }
+
+// GoUnsafeToGlibNoneReleaseFunction implements ConvertibleType.
+func (b BaseConversions) GoUnsafeToGlibNoneReleaseFunction() string {
+	return b.ToGlibNoneReleaseFunction
+}
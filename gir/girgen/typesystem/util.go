@@ -141,6 +141,8 @@ func infoFromAnyGir(girAny any) (string, gir.InfoAttrs, gir.InfoElements) {
 		return t.Name, attrs, elements
 	case *gir.Signal:
 		return t.Name, attrs, elements
+	case *gir.Property:
+		return t.Name, attrs, elements
 	default:
 		panic(fmt.Sprintf("received unhandled type: %T", t))
 	}
@@ -96,6 +96,13 @@ type CallableAttrs struct {
 	MovedTo     string       `xml:"moved-to,attr"`
 	Parameters  *Parameters  `xml:"http://www.gtk.org/introspection/core/1.0 parameters"`
 	ReturnValue *ReturnValue `xml:"http://www.gtk.org/introspection/core/1.0 return-value"`
+
+	// GLibSyncFunc, GLibAsyncFunc and GLibFinishFunc contain the names of the related callables
+	// of an asynchronous operation
+	GLibSyncFunc   string `xml:"http://www.gtk.org/introspection/glib/1.0 sync-func,attr"`
+	GLibAsyncFunc  string `xml:"http://www.gtk.org/introspection/glib/1.0 async-func,attr"`
+	GLibFinishFunc string `xml:"http://www.gtk.org/introspection/glib/1.0 finish-func,attr"`
+
 	InfoAttrs
 	InfoElements
 }
@@ -139,6 +146,7 @@ type Class struct {
 	VirtualMethods []*VirtualMethod `xml:"http://www.gtk.org/introspection/core/1.0 virtual-method"`
 	Fields         []*Field         `xml:"http://www.gtk.org/introspection/core/1.0 field"`
 	Signals        []*Signal        `xml:"http://www.gtk.org/introspection/glib/1.0 signal"`
+	Properties     []*Property      `xml:"http://www.gtk.org/introspection/core/1.0 property"`
 }
 
 // Find implements Searchable.
@@ -179,6 +187,12 @@ func (c Class) Find(ident string) any {
 		}
 	}
 
+	for _, property := range c.Properties {
+		if property.Name == ident {
+			return property
+		}
+	}
+
 	return nil
 }
 
@@ -331,6 +345,7 @@ type Interface struct {
 	VirtualMethods []*VirtualMethod `xml:"http://www.gtk.org/introspection/core/1.0 virtual-method"`
 	Prerequisites  []*Prerequisite  `xml:"http://www.gtk.org/introspection/core/1.0 prerequisite"`
 	Signals        []*Signal        `xml:"http://www.gtk.org/introspection/glib/1.0 signal"`
+	Properties     []*Property      `xml:"http://www.gtk.org/introspection/core/1.0 property"`
 
 	InfoAttrs
 	InfoElements
@@ -362,6 +377,12 @@ func (i Interface) Find(ident string) any {
 		}
 	}
 
+	for _, property := range i.Properties {
+		if property.Name == ident {
+			return property
+		}
+	}
+
 	return nil
 }
 
@@ -436,7 +457,28 @@ type Prerequisite struct {
 	Name    string   `xml:"name,attr"`
 }
 
-type Property struct{}
+type Property struct {
+	XMLName       xml.Name `xml:"http://www.gtk.org/introspection/core/1.0 property"`
+	Name          string   `xml:"name,attr"`
+	Readable      *bool    `xml:"readable,attr"` // default true
+	Writable      bool     `xml:"writable,attr"`
+	Construct     bool     `xml:"construct,attr"`
+	ConstructOnly bool     `xml:"construct-only,attr"`
+	Setter        string   `xml:"setter,attr"`
+	Getter        string   `xml:"getter,attr"`
+	DefaultValue  string   `xml:"default-value,attr"`
+
+	TransferOwnership
+	InfoAttrs
+	InfoElements
+	AnyType
+}
+
+// IsReadable returns true if the property can be read. Properties are readable
+// unless explicitly marked otherwise.
+func (p Property) IsReadable() bool {
+	return p.Readable == nil || *p.Readable
+}
 
 type Record struct {
 	XMLName              xml.Name `xml:"http://www.gtk.org/introspection/core/1.0 record"`
@@ -487,12 +529,11 @@ func (r Record) Find(typ string) any {
 			return union
 		}
 	}
-	// TODO: properties
-	// for _, property := range r.Properties {
-	// 	if property.Name == typ {
-	// 		return property
-	// 	}
-	// }
+	for _, property := range r.Properties {
+		if property.Name == typ {
+			return property
+		}
+	}
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package gir_test
 
 import (
+	"encoding/xml"
 	"testing"
 
 	"github.com/go-gst/go-glib/gir"
@@ -28,3 +29,53 @@ func TestVersionLessEqual(t *testing.T) {
 		}
 	}
 }
+
+func TestPropertyUnmarshal(t *testing.T) {
+	const class = `<class xmlns="http://www.gtk.org/introspection/core/1.0" name="Binding">
+  <property name="flags" writable="1" construct-only="1" transfer-ownership="none" getter="get_flags">
+    <type name="BindingFlags"/>
+  </property>
+  <property name="secret" readable="0" writable="1" transfer-ownership="none">
+    <type name="utf8" c:type="gchar*" xmlns:c="http://www.gtk.org/introspection/c/1.0"/>
+  </property>
+</class>`
+
+	var c gir.Class
+	if err := xml.Unmarshal([]byte(class), &c); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Properties) != 2 {
+		t.Fatalf("expected 2 properties, got %d", len(c.Properties))
+	}
+
+	flags := c.Properties[0]
+	if flags.Name != "flags" || !flags.IsReadable() || !flags.Writable || !flags.ConstructOnly || flags.Getter != "get_flags" {
+		t.Errorf("unexpected flags property: %+v", flags)
+	}
+	if flags.Type == nil || flags.Type.Name != "BindingFlags" {
+		t.Errorf("unexpected flags property type: %+v", flags.Type)
+	}
+
+	secret := c.Properties[1]
+	if secret.IsReadable() || !secret.Writable {
+		t.Errorf("expected secret to be write only: %+v", secret)
+	}
+
+	if c.Find("secret") != secret {
+		t.Errorf("expected Find to return the property")
+	}
+}
+
+func TestAsyncMethodUnmarshal(t *testing.T) {
+	const method = `<method xmlns="http://www.gtk.org/introspection/core/1.0" xmlns:glib="http://www.gtk.org/introspection/glib/1.0" name="close_async" glib:finish-func="close_finish" glib:sync-func="close"/>`
+
+	var m gir.Method
+	if err := xml.Unmarshal([]byte(method), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.GLibFinishFunc != "close_finish" || m.GLibSyncFunc != "close" || m.GLibAsyncFunc != "" {
+		t.Errorf("unexpected async attributes: %+v", m.CallableAttrs)
+	}
+}
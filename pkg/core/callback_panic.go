@@ -0,0 +1,105 @@
+// core contains the policies that apply to all go code that is called from C, like signal handlers,
+// SourceFuncs and virtual method overrides. The registries for the data that is passed to C are in the
+// sub packages.
+package core
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync/atomic"
+)
+
+// CallbackPanic describes a panic in go code that was called from C, see [SetCallbackPanicHandler].
+type CallbackPanic struct {
+	// Callback names the callback that panicked. This is the go function of a closure, e.g. a signal
+	// handler, or the exported function that C called for other callbacks.
+	Callback string
+	// Value is the value that was passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine at the panic.
+	Stack []byte
+	// RegisteredAt contains the frames of the call site that registered the callback, e.g. the Connect
+	// call of a signal handler, the innermost frame first. It is empty if the call site is unknown.
+	RegisteredAt []runtime.Frame
+}
+
+// String formats the panic value, the callback and the registration site.
+func (p CallbackPanic) String() string {
+	msg := strings.Builder{}
+
+	fmt.Fprintf(&msg, "panic in callback %s: %v", p.Callback, p.Value)
+
+	if len(p.RegisteredAt) == 0 {
+		return msg.String()
+	}
+
+	msg.WriteString("\n\nCallback added at:")
+	for _, frame := range p.RegisteredAt {
+		fmt.Fprintf(&msg, "\n\t%s at %s:%d", frame.Function, frame.File, frame.Line)
+	}
+
+	return msg.String()
+}
+
+var callbackPanicHandler atomic.Pointer[func(info CallbackPanic)]
+
+// SetCallbackPanicHandler sets the handler that is called when go code that was called from C panics. Without
+// a handler, the panic unwinds through the C frames, which aborts the process.
+//
+// If a handler is set, the panic is recovered in the function that C called and passed to the handler. The
+// callback then returns the zero value to C, e.g. FALSE for a SourceFunc, which removes the source. Out
+// parameters and the return value of signal handlers are left untouched. The state of the C library is not
+// rolled back, e.g. a panicking virtual method override does not chain up to the parent. Panics in the class
+// and instance init of go subclasses are never recovered, they would leave the type half initialized.
+//
+// The handler is called on the goroutine of the callback, it must not panic itself. Passing nil restores
+// the default.
+func SetCallbackPanicHandler(handler func(info CallbackPanic)) {
+	if handler == nil {
+		callbackPanicHandler.Store(nil)
+		return
+	}
+
+	callbackPanicHandler.Store(&handler)
+}
+
+// HandleCallbackPanic passes a recovered panic to the handler that was set with [SetCallbackPanicHandler]. It
+// returns false if no handler is set, the caller must re-panic then. The Stack is filled in if it is empty,
+// so this must be called from the deferred function that recovered the panic.
+func HandleCallbackPanic(info CallbackPanic) bool {
+	handler := callbackPanicHandler.Load()
+	if handler == nil {
+		return false
+	}
+
+	if info.Stack == nil {
+		info.Stack = debug.Stack()
+	}
+
+	(*handler)(info)
+
+	return true
+}
+
+// RecoverCallback recovers a panic in the exported function callback, if a handler is set with
+// [SetCallbackPanicHandler]. It must be deferred directly by the exported function:
+//
+//	defer core.RecoverCallback("_goglib_glib2_SourceFunc")
+func RecoverCallback(callback string) {
+	if callbackPanicHandler.Load() == nil {
+		// keep panicking without recovering, so the stack trace of the crash is unchanged
+		return
+	}
+
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if !HandleCallbackPanic(CallbackPanic{Callback: callback, Value: r}) {
+		// the handler was removed concurrently
+		panic(r)
+	}
+}
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func panickingCallback() (ret int) {
+	defer RecoverCallback("panickingCallback")
+
+	ret = 1
+
+	panic("boom")
+}
+
+func TestRecoverCallback(t *testing.T) {
+	var got []CallbackPanic
+
+	SetCallbackPanicHandler(func(info CallbackPanic) {
+		got = append(got, info)
+	})
+	defer SetCallbackPanicHandler(nil)
+
+	if ret := panickingCallback(); ret != 1 {
+		t.Fatalf("Expected the callback to return the assigned value, got %d", ret)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("Expected the handler to be called once, got %d calls", len(got))
+	}
+
+	if got[0].Callback != "panickingCallback" || got[0].Value != "boom" {
+		t.Fatalf("Unexpected panic info: %v", got[0])
+	}
+
+	if !strings.Contains(string(got[0].Stack), "core.panickingCallback") {
+		t.Fatalf("Expected the stack of the panic, got %s", got[0].Stack)
+	}
+}
+
+func TestRecoverCallbackWithoutHandler(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Fatalf("Expected the panic to continue, got %v", r)
+		}
+	}()
+
+	panickingCallback()
+
+	t.Fatalf("Expected a panic")
+}
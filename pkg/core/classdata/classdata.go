@@ -33,6 +33,18 @@ func StoreVirtualMethod(
 	}] = f
 }
 
+// LoadVirtualMethod loads the virtual method that was stored for the given class. It returns nil
+// if no virtual method was stored.
+func LoadVirtualMethod(
+	class unsafe.Pointer,
+	name string,
+) any {
+	return registry[virtualMethodIdentifier{
+		class: class,
+		name:  name,
+	}]
+}
+
 // LoadVirtualMethodFromInstance loads the virtual method for a given instance of a class.
 // This is used by the generated bindings to call the virtual method.
 //
@@ -6,6 +6,8 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+
+	"github.com/go-gst/go-glib/pkg/core"
 )
 
 // FrameSize is the number of frames that FuncStack should trace back from.
@@ -104,7 +106,8 @@ func (fs *FuncStack) Panicf(msgf string, v ...interface{}) {
 	panic(msg.String())
 }
 
-// TryRepanic attempts to recover a panic. If successful, it will re-panic with
+// TryRepanic attempts to recover a panic. If a handler is set with core.SetCallbackPanicHandler,
+// the panic is passed to it with the frames of the FuncStack. Otherwise it will re-panic with
 // the trace, or none if there is already one.
 func (fs *FuncStack) TryRepanic() {
 	panicking := recover()
@@ -112,6 +115,10 @@ func (fs *FuncStack) TryRepanic() {
 		return
 	}
 
+	if core.HandleCallbackPanic(fs.callbackPanic(panicking)) {
+		return
+	}
+
 	if msg, ok := panicking.(string); ok {
 		if strings.Contains(msg, headerSignature) {
 			// We can just repanic as-is.
@@ -121,3 +128,33 @@ func (fs *FuncStack) TryRepanic() {
 
 	fs.Panicf("unexpected panic caught: %v", panicking)
 }
+
+// callbackPanic describes a panic of the function for core.HandleCallbackPanic.
+func (fs *FuncStack) callbackPanic(panicking any) core.CallbackPanic {
+	info := core.CallbackPanic{
+		Callback: "unknown closure",
+		Value:    panicking,
+	}
+
+	if fs == nil {
+		return info
+	}
+
+	if fn := runtime.FuncForPC(fs.Value().Pointer()); fn != nil {
+		info.Callback = fn.Name()
+	}
+
+	if pcs := fs.ValidFrames(); len(pcs) > 0 {
+		frames := runtime.CallersFrames(pcs)
+		for {
+			frame, more := frames.Next()
+			info.RegisteredAt = append(info.RegisteredAt, frame)
+
+			if !more {
+				break
+			}
+		}
+	}
+
+	return info
+}
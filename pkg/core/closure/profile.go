@@ -1,9 +1,40 @@
 package closure
 
-import "runtime/pprof"
+import (
+	"io"
+	"runtime/pprof"
+
+	"github.com/go-gst/go-glib/pkg/core/internal/callsites"
+)
 
 var profile *pprof.Profile
 
 func init() {
 	profile = pprof.NewProfile("goglib/closures")
+
+	callsites.Register("closure", Stats)
+}
+
+// CallSiteStats is the number of live registrations that were made at one call site.
+type CallSiteStats = callsites.Stats
+
+// Stats returns the number of live closures grouped by the call site that registered them, the call site
+// with the most closures first. The call site is the first frame of the FuncStack of the closure, e.g. the
+// Connect call of a signal handler. Call sites with a growing count usually leak closures.
+//
+// core.WriteRegistrations writes the stats of all registries.
+func Stats() []CallSiteStats {
+	counter := callsites.Counter{}
+
+	closureRegistry.Range(func(_, value any) bool {
+		counter[value.(*FuncStack).Frames[0]]++
+		return true
+	})
+
+	return counter.Stats()
+}
+
+// WriteStats writes the Stats to w.
+func WriteStats(w io.Writer) error {
+	return callsites.Write(w, "closure", Stats())
 }
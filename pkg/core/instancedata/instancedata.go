@@ -3,6 +3,8 @@ package instancedata
 import (
 	"sync"
 	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/internal/callsites"
 )
 
 // instanceDataKey is the key that is used to store the data for the instance.
@@ -21,6 +23,9 @@ type instanceDataEntry struct {
 	data any
 	// once tells the lookup to delete the entry after it is used
 	once bool
+	// site is the program counter of the call site that registered the entry, it is only
+	// recorded in debug mode
+	site uintptr
 }
 
 var instanceDataLock sync.Mutex
@@ -33,6 +38,12 @@ var instanceDataRegistry map[instanceDataKey]instanceDataEntry = make(map[instan
 //
 // the key needed for lookup the data again is returned, but as an unsafe.Pointer.
 func register(instance unsafe.Pointer, key unsafe.Pointer, data any, once bool) unsafe.Pointer {
+	// the call site is the first caller of Register or RegisterOnce that is not a generated binding
+	var site uintptr
+	if callsites.Debug {
+		site = callsites.CallerOutsideGenerated(2)
+	}
+
 	instanceDataLock.Lock()
 	defer instanceDataLock.Unlock()
 
@@ -45,6 +56,11 @@ func register(instance unsafe.Pointer, key unsafe.Pointer, data any, once bool)
 	instanceDataRegistry[k] = instanceDataEntry{
 		data: data,
 		once: once,
+		site: site,
+	}
+
+	if callsites.Debug {
+		profile.Add(k, 2)
 	}
 
 	return unsafe.Pointer(&k)
@@ -79,6 +95,9 @@ func Load(k unsafe.Pointer) any {
 
 	if fs.once {
 		delete(instanceDataRegistry, *key)
+		if callsites.Debug {
+			profile.Remove(*key)
+		}
 	}
 
 	return fs.data
@@ -90,5 +109,10 @@ func Delete(k unsafe.Pointer) {
 
 	key := (*instanceDataKey)(k)
 
-	delete(instanceDataRegistry, *key)
+	if _, ok := instanceDataRegistry[*key]; ok {
+		delete(instanceDataRegistry, *key)
+		if callsites.Debug {
+			profile.Remove(*key)
+		}
+	}
 }
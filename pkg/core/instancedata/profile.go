@@ -0,0 +1,44 @@
+package instancedata
+
+import (
+	"io"
+	"runtime/pprof"
+
+	"github.com/go-gst/go-glib/pkg/core/internal/callsites"
+)
+
+// profile contains the stacks of the live registrations in debug mode.
+var profile *pprof.Profile
+
+func init() {
+	profile = pprof.NewProfile("goglib/instancedata")
+
+	callsites.Register("instancedata", Stats)
+}
+
+// CallSiteStats is the number of live registrations that were made at one call site.
+type CallSiteStats = callsites.Stats
+
+// Stats returns the number of live instance data registrations grouped by the call site that registered them,
+// the call site with the most registrations first. The call site is the first caller of Register or RegisterOnce
+// that is not in a generated file, it is only recorded if the environment variable GOGLIB_DEBUG_REGISTRATIONS
+// is set.
+//
+// core.WriteRegistrations writes the stats of all registries.
+func Stats() []CallSiteStats {
+	instanceDataLock.Lock()
+	defer instanceDataLock.Unlock()
+
+	counter := callsites.Counter{}
+
+	for _, entry := range instanceDataRegistry {
+		counter[entry.site]++
+	}
+
+	return counter.Stats()
+}
+
+// WriteStats writes the Stats to w.
+func WriteStats(w io.Writer) error {
+	return callsites.Write(w, "instancedata", Stats())
+}
@@ -0,0 +1,164 @@
+// callsites groups the live registrations of the core registries by the call site that registered them.
+// It is used for the leak diagnostics of the closure, userdata and instancedata packages.
+package callsites
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DebugEnv is the environment variable that enables the debug mode of the registries, if it is set to a non
+// empty value. In debug mode the registries record the call sites of the registrations and do more expensive
+// tracking, see the packages of the registries.
+const DebugEnv = "GOGLIB_DEBUG_REGISTRATIONS"
+
+// Debug is true if DebugEnv is set. Registries can do more expensive tracking in debug mode.
+var Debug = os.Getenv(DebugEnv) != ""
+
+// Stats is the number of live registrations that were made at one call site.
+type Stats struct {
+	// Function, File and Line describe the call site. They are empty if the call site is unknown.
+	Function string
+	File     string
+	Line     int
+
+	// Count is the number of live registrations of the call site.
+	Count int
+}
+
+// String formats the stats as "count function at file:line".
+func (s Stats) String() string {
+	if s.Function == "" {
+		return fmt.Sprintf("%d\tunknown call site", s.Count)
+	}
+
+	return fmt.Sprintf("%d\t%s at %s:%d", s.Count, s.Function, s.File, s.Line)
+}
+
+// Counter counts registrations by the program counter of the call site.
+type Counter map[uintptr]int
+
+// Stats resolves the program counters and returns the stats sorted by count, the highest count first.
+func (c Counter) Stats() []Stats {
+	stats := make([]Stats, 0, len(c))
+
+	for pc, count := range c {
+		s := Stats{Count: count}
+
+		if pc != 0 {
+			frame := resolve(pc)
+
+			s.Function = frame.Function
+			s.File = frame.File
+			s.Line = frame.Line
+		}
+
+		stats = append(stats, s)
+	}
+
+	slices.SortFunc(stats, func(a, b Stats) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Function, b.Function)
+	})
+
+	return stats
+}
+
+// resolve returns the frame of pc, which is a return address like the ones that runtime.Callers returns.
+func resolve(pc uintptr) runtime.Frame {
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	return frame
+}
+
+// Describe formats the call site of pc as "function at file:line".
+func Describe(pc uintptr) string {
+	if pc == 0 {
+		return "unknown call site"
+	}
+
+	frame := resolve(pc)
+
+	return fmt.Sprintf("%s at %s:%d", frame.Function, frame.File, frame.Line)
+}
+
+// maxGeneratedFrames is the number of frames that CallerOutsideGenerated looks at.
+const maxGeneratedFrames = 8
+
+// CallerOutsideGenerated returns the program counter of the call site skip frames above the caller of
+// CallerOutsideGenerated. Frames in generated files (*.gen.go) are skipped, so the registrations of the
+// generated bindings are counted for the code that calls the bindings. If all frames are generated, the
+// call site skip frames above is returned.
+func CallerOutsideGenerated(skip int) uintptr {
+	var pcs [maxGeneratedFrames]uintptr
+
+	// skip runtime.Callers and CallerOutsideGenerated
+	n := runtime.Callers(skip+2, pcs[:])
+	if n == 0 {
+		return 0
+	}
+
+	for _, pc := range pcs[:n] {
+		if !strings.HasSuffix(resolve(pc).File, ".gen.go") {
+			return pc
+		}
+	}
+
+	return pcs[0]
+}
+
+// Write writes the stats of a registry to w.
+func Write(w io.Writer, registry string, stats []Stats) error {
+	total := 0
+	for _, s := range stats {
+		total += s.Count
+	}
+
+	if _, err := fmt.Fprintf(w, "goglib: %d live %s registrations\n", total, registry); err != nil {
+		return err
+	}
+
+	for _, s := range stats {
+		if _, err := fmt.Fprintf(w, "\t%s\n", s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var (
+	registriesLock sync.Mutex
+	registries     []registry
+)
+
+type registry struct {
+	name  string
+	stats func() []Stats
+}
+
+// Register adds a registry to WriteAll.
+func Register(name string, stats func() []Stats) {
+	registriesLock.Lock()
+	defer registriesLock.Unlock()
+
+	registries = append(registries, registry{name: name, stats: stats})
+}
+
+// WriteAll writes the stats of all registered registries to w.
+func WriteAll(w io.Writer) {
+	registriesLock.Lock()
+	defer registriesLock.Unlock()
+
+	for _, r := range registries {
+		Write(w, r.name, r.stats())
+	}
+}
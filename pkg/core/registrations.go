@@ -0,0 +1,20 @@
+package core
+
+import (
+	"io"
+
+	"github.com/go-gst/go-glib/pkg/core/internal/callsites"
+)
+
+// WriteRegistrations writes the live registrations of the closure, userdata and instancedata registries to w,
+// grouped by the call site that registered them. Call sites with a growing count usually leak registrations.
+// Registries that are not linked into the program are omitted.
+//
+// The call sites are only recorded if the environment variable GOGLIB_DEBUG_REGISTRATIONS is set, otherwise
+// the userdata and instancedata registrations are counted for an unknown call site. Programs call it to find
+// leaks, e.g. at the end of main or from a debug handler:
+//
+//	defer core.WriteRegistrations(os.Stderr)
+func WriteRegistrations(w io.Writer) {
+	callsites.WriteAll(w)
+}
@@ -5,49 +5,201 @@ package userdata
 */
 import "C"
 import (
+	"fmt"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/internal/callsites"
 )
 
 const chunkSize = 1024 // Number of pointers to allocate in one chunk
 
-var (
-	mu sync.Mutex
-	// unused is a list of unused but valid malloc'ed C pointers. These can be used
-	// to store userdata in a map and then be passed to c code without the runtime ever complaining.
+// quarantineSize is the number of deleted pointers per shard that are kept poisoned in debug mode,
+// before they are reused.
+const quarantineSize = chunkSize
+
+// chunk is a block of malloc'ed memory, every byte of it is a valid C pointer that can be used
+// as userdata. The pointers of a chunk are only handed out by the heap that allocated the chunk.
+type chunk struct {
+	// block is the malloc'ed memory
+	block unsafe.Pointer
+	// owner is the heap that hands out the pointers of the chunk
+	owner *heap
+
+	// unused are the pointers of the chunk that are not registered. The chunk is
+	// released when all of its pointers are unused.
 	unused []unsafe.Pointer
+}
+
+// heap hands out the C pointers of its chunks. Registrations use the current heap until its lock
+// is contended, then they move on to the next heap, see lockHeap.
+type heap struct {
+	mu sync.Mutex
+
+	// available contains the chunks that have unused pointers, new pointers are taken from the
+	// last one. An empty chunk is kept at the front, so partially used chunks are filled first.
+	available []*chunk
+
+	// empty is the number of chunks without registered pointers. One empty chunk is kept, so
+	// registrations and deletions at a chunk boundary do not allocate and free a chunk every time.
+	empty int
+
+	// pad the heap to its own cache lines
+	_ [64]byte
+}
+
+var (
+	heaps       [numShards]heap
+	currentHeap atomic.Uint32
 )
 
-func allocateChunk() {
+// lockHeap locks and returns the current heap. If it is locked by another goroutine, the next heap
+// becomes the current heap, so concurrent registrations rarely wait for the same lock, while a single
+// goroutine keeps using the chunks of one heap.
+func lockHeap() *heap {
+	i := currentHeap.Load()
+
+	for range numShards {
+		h := &heaps[i%numShards]
+		if h.mu.TryLock() {
+			return h
+		}
+
+		i = currentHeap.Add(1)
+	}
+
+	// all heaps are contended, wait for the current one
+	h := &heaps[i%numShards]
+	h.mu.Lock()
+
+	return h
+}
+
+func (h *heap) allocateChunk() {
 	// Allocate a single block of memory for `chunkSize` pointers
 	block := C.malloc(C.size_t(chunkSize))
 
+	c := &chunk{
+		block:  block,
+		owner:  h,
+		unused: make([]unsafe.Pointer, 0, chunkSize),
+	}
+
 	// Divide the block into individual 1-byte pointers
 	for i := range chunkSize {
-		ptr := unsafe.Pointer(uintptr(block) + uintptr(i))
-		unused = append(unused, ptr)
+		c.unused = append(c.unused, unsafe.Add(block, i))
+	}
+
+	h.available = append(h.available, c)
+	h.empty++
+}
+
+// releaseChunk frees the memory of an empty chunk that is not counted in empty. Its pointers are
+// no longer userdata pointers.
+func (h *heap) releaseChunk(c *chunk) {
+	h.available = slices.DeleteFunc(h.available, func(a *chunk) bool { return a == c })
+
+	C.free(c.block)
+	c.block = nil
+	c.unused = nil
+}
+
+// getPointer retrieves an unused C pointer and its chunk from the available chunks, allocating a new
+// chunk if necessary. The lock of the heap must be held.
+func (h *heap) getPointer() (unsafe.Pointer, *chunk) {
+	if len(h.available) == 0 {
+		h.allocateChunk()
+	}
+
+	c := h.available[len(h.available)-1]
+
+	if len(c.unused) == chunkSize {
+		h.empty--
+	}
+
+	// Pop a pointer from the free list of the chunk
+	ptr := c.unused[len(c.unused)-1]
+	c.unused = c.unused[:len(c.unused)-1]
+
+	if len(c.unused) == 0 {
+		h.available = h.available[:len(h.available)-1]
+	}
+
+	return ptr, c
+}
+
+// returnPointer adds a pointer of the chunk c back to the free list for reuse. The lock of the
+// heap must be held.
+func (h *heap) returnPointer(c *chunk, ptr unsafe.Pointer) {
+	if len(c.unused) == 0 {
+		h.available = append(h.available, c)
+	}
+
+	c.unused = append(c.unused, ptr)
+
+	if len(c.unused) < chunkSize {
+		return
+	}
+
+	if h.empty > 0 {
+		h.releaseChunk(c)
+		return
 	}
+
+	h.empty++
+
+	// move the empty chunk to the front, so it is used last
+	i := slices.Index(h.available, c)
+	h.available[0], h.available[i] = h.available[i], h.available[0]
+}
+
+// quarantined is a deleted pointer in the quarantine and the chunk it belongs to.
+type quarantined struct {
+	ptr   unsafe.Pointer
+	chunk *chunk
 }
 
-// getPointer retrieves an unused C pointer from the free list, allocating a new chunk if necessary
-func getPointer() unsafe.Pointer {
-	mu.Lock()
-	defer mu.Unlock()
+// quarantine keeps deleted pointers poisoned in debug mode, so they are not reused for a while,
+// see [callsites.Debug].
+type quarantine struct {
+	// poisoned maps the deleted pointers in the quarantine to the call site that registered them.
+	poisoned map[unsafe.Pointer]uintptr
+	// pointers contains the deleted pointers in the order they were deleted.
+	pointers []quarantined
+}
+
+// add poisons the deleted pointer ptr of chunk c, site is the call site that registered it. It returns
+// the pointer that can be reused instead, or false if no pointer can be reused yet.
+func (q *quarantine) add(ptr unsafe.Pointer, c *chunk, site uintptr) (quarantined, bool) {
+	if q.poisoned == nil {
+		q.poisoned = make(map[unsafe.Pointer]uintptr)
+	}
+
+	q.poisoned[ptr] = site
+	q.pointers = append(q.pointers, quarantined{ptr: ptr, chunk: c})
 
-	if len(unused) == 0 {
-		allocateChunk()
+	if len(q.pointers) <= quarantineSize {
+		return quarantined{}, false
 	}
 
-	// Pop a pointer from the free list
-	ptr := unused[len(unused)-1]
-	unused = unused[:len(unused)-1]
-	return ptr
+	// reuse the oldest pointer of the quarantine instead
+	oldest := q.pointers[0]
+	q.pointers = slices.Delete(q.pointers, 0, 1)
+
+	delete(q.poisoned, oldest.ptr)
+
+	return oldest, true
 }
 
-// returnPointer adds a pointer back to the free list for reuse
-func returnPointer(ptr unsafe.Pointer) {
-	mu.Lock()
-	defer mu.Unlock()
+// check panics if ptr was deleted in debug mode and is still in the quarantine. op is the
+// operation that used the pointer.
+func (q *quarantine) check(ptr unsafe.Pointer, op string) {
+	site, ok := q.poisoned[ptr]
+	if !ok {
+		return
+	}
 
-	unused = append(unused, ptr)
+	panic(fmt.Sprintf("userdata: %s of pointer %p after it was deleted, it was registered by %s", op, ptr, callsites.Describe(site)))
 }
@@ -1,48 +1,228 @@
 package userdata
 
 import (
+	"strings"
 	"testing"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/internal/callsites"
 )
 
+// resetHeap replaces the chunks of the heap for testing, the old chunks are leaked.
+func resetHeap(h *heap) {
+	h.mu.Lock()
+	h.available = nil
+	h.empty = 0
+	h.mu.Unlock()
+}
+
 func TestGetPointer(t *testing.T) {
-	// Clear the free list for testing
-	mu.Lock()
-	unused = nil
-	mu.Unlock()
+	h := &heaps[0]
+
+	resetHeap(h)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
 
 	// Get a pointer and ensure it's not nil
-	ptr := getPointer()
+	ptr, c := h.getPointer()
 	if ptr == nil {
 		t.Fatalf("Expected a valid pointer, got nil")
 	}
 
+	// Ensure the pointer belongs to the heap
+	if c.owner != h {
+		t.Fatalf("Expected the pointer to be owned by the heap")
+	}
+
 	// Ensure the free list has been reduced by one
-	mu.Lock()
-	defer mu.Unlock()
-	if len(unused) != chunkSize-1 {
-		t.Fatalf("Expected freeList size to be %d, got %d", chunkSize-1, len(unused))
+	if len(c.unused) != chunkSize-1 {
+		t.Fatalf("Expected freeList size to be %d, got %d", chunkSize-1, len(c.unused))
 	}
 }
 
 func TestReturnPointer(t *testing.T) {
-	// Clear the free list for testing
-	mu.Lock()
-	unused = nil
-	mu.Unlock()
+	h := &heaps[0]
+
+	resetHeap(h)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
 
 	// Get a pointer and return it
-	ptr := getPointer()
-	returnPointer(ptr)
+	ptr, c := h.getPointer()
+	h.returnPointer(c, ptr)
 
 	// Ensure the pointer is back in the free list
-	mu.Lock()
-	defer mu.Unlock()
-	if len(unused) != chunkSize {
-		t.Fatalf("Expected freeList size to be %d, got %d", chunkSize, len(unused))
+	if len(c.unused) != chunkSize {
+		t.Fatalf("Expected freeList size to be %d, got %d", chunkSize, len(c.unused))
 	}
 
 	// Ensure the returned pointer is the same as the one added
-	if unused[len(unused)-1] != ptr {
+	if c.unused[len(c.unused)-1] != ptr {
 		t.Fatalf("Expected returned pointer to match, but it did not")
 	}
+
+	// Ensure the empty chunk is kept
+	if c.block == nil || h.empty != 1 {
+		t.Fatalf("Expected the empty chunk to be kept")
+	}
+}
+
+func TestReleaseChunk(t *testing.T) {
+	h := &heaps[0]
+
+	resetHeap(h)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Use two chunks
+	ptrs := make([]unsafe.Pointer, 0, 2*chunkSize)
+	chunks := make([]*chunk, 0, 2*chunkSize)
+	for range 2 * chunkSize {
+		ptr, c := h.getPointer()
+		ptrs = append(ptrs, ptr)
+		chunks = append(chunks, c)
+	}
+
+	if len(h.available) != 0 || h.empty != 0 {
+		t.Fatalf("Expected all chunks to be used, got %d available", len(h.available))
+	}
+
+	first, second := chunks[0], chunks[chunkSize]
+
+	for i, ptr := range ptrs {
+		h.returnPointer(chunks[i], ptr)
+	}
+
+	// The first chunk is kept, the second one is released
+	if len(h.available) != 1 || h.available[0] != first || h.empty != 1 {
+		t.Fatalf("Expected only the first chunk to be available")
+	}
+
+	if second.block != nil {
+		t.Fatalf("Expected the second chunk to be released")
+	}
+
+	// Fill the first chunk and use one pointer of a new chunk
+	ptrs = ptrs[:0]
+	for range chunkSize {
+		ptr, _ := h.getPointer()
+		ptrs = append(ptrs, ptr)
+	}
+
+	ptr, third := h.getPointer()
+
+	// Empty the new chunk and then the first chunk
+	h.returnPointer(third, ptr)
+	for _, ptr := range ptrs {
+		h.returnPointer(first, ptr)
+	}
+
+	// Both chunks are empty, only the new chunk is kept
+	if len(h.available) != 1 || h.available[0] != third || h.empty != 1 {
+		t.Fatalf("Expected one empty chunk, got %d available", len(h.available))
+	}
+
+	if first.block != nil {
+		t.Fatalf("Expected the first chunk to be released")
+	}
+}
+
+func TestPartiallyUsedChunkFirst(t *testing.T) {
+	h := &heaps[0]
+
+	resetHeap(h)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ptrs := make([]unsafe.Pointer, 0, chunkSize)
+	var first *chunk
+	for range chunkSize {
+		ptr, c := h.getPointer()
+		ptrs = append(ptrs, ptr)
+		first = c
+	}
+
+	ptr, second := h.getPointer()
+
+	// Empty the second chunk and free one pointer of the first chunk
+	h.returnPointer(second, ptr)
+	h.returnPointer(first, ptrs[0])
+
+	// The partially used first chunk is used before the empty second chunk
+	if _, c := h.getPointer(); c != first {
+		t.Fatalf("Expected the pointer to be taken from the partially used chunk")
+	}
+}
+
+func TestPoisoned(t *testing.T) {
+	callsites.Debug = true
+	defer func() { callsites.Debug = false }()
+
+	ptr := Register(1)
+	Delete(ptr)
+
+	expectPanic := func(op string, f func()) {
+		t.Helper()
+
+		defer func() {
+			r := recover()
+
+			msg, _ := r.(string)
+			if !strings.Contains(msg, op) || !strings.Contains(msg, ".TestPoisoned") {
+				t.Fatalf("Expected a panic for the %s with the registration site, got %v", op, r)
+			}
+		}()
+
+		f()
+	}
+
+	expectPanic("load", func() { Load(ptr) })
+	expectPanic("delete", func() { Delete(ptr) })
+}
+
+func BenchmarkRegisterLoadDelete(b *testing.B) {
+	for b.Loop() {
+		ptr := Register(b)
+		if Load(ptr) == nil {
+			b.Fatal("Expected the userdata to be registered")
+		}
+		Delete(ptr)
+	}
+}
+
+func BenchmarkRegisterLoadDeleteParallel(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			ptr := Register(b)
+			if Load(ptr) == nil {
+				b.Fatal("Expected the userdata to be registered")
+			}
+			Delete(ptr)
+		}
+	})
+}
+
+func BenchmarkLoadParallel(b *testing.B) {
+	ptr := Register(b)
+	defer Delete(ptr)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if Load(ptr) == nil {
+				b.Fatal("Expected the userdata to be registered")
+			}
+		}
+	})
+}
+
+func BenchmarkRegisterOnceParallel(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			Load(RegisterOnce(b))
+		}
+	})
 }
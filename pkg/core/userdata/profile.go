@@ -0,0 +1,47 @@
+package userdata
+
+import (
+	"io"
+	"runtime/pprof"
+
+	"github.com/go-gst/go-glib/pkg/core/internal/callsites"
+)
+
+// profile contains the stacks of the live registrations. The registrations are only recorded if the
+// environment variable GOGLIB_DEBUG_REGISTRATIONS is set, because the profile serializes all registrations.
+var profile *pprof.Profile
+
+func init() {
+	profile = pprof.NewProfile("goglib/userdata")
+
+	callsites.Register("userdata", Stats)
+}
+
+// CallSiteStats is the number of live registrations that were made at one call site.
+type CallSiteStats = callsites.Stats
+
+// Stats returns the number of live userdata registrations grouped by the call site that registered them,
+// the call site with the most registrations first. The call site is the first caller of Register or RegisterOnce
+// that is not in a generated file, it is only recorded in debug mode, see the package documentation.
+//
+// core.WriteRegistrations writes the stats of all registries.
+func Stats() []CallSiteStats {
+	counter := callsites.Counter{}
+
+	for i := range shards {
+		s := &shards[i]
+
+		s.mu.Lock()
+		for cpointer := range s.entries {
+			counter[s.sites[cpointer]]++
+		}
+		s.mu.Unlock()
+	}
+
+	return counter.Stats()
+}
+
+// WriteStats writes the Stats to w.
+func WriteStats(w io.Writer) error {
+	return callsites.Write(w, "userdata", Stats())
+}
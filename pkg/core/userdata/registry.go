@@ -1,10 +1,16 @@
 // userdata manages data that needs to be passed to C code, such as
 // callbacks
+//
+// If the environment variable GOGLIB_DEBUG_REGISTRATIONS is set, the call sites of the registrations
+// are recorded and deleted pointers are poisoned for a while instead of being reused, using them with
+// Load or Delete panics with the call site that registered them.
 package userdata
 
 import (
 	"sync"
 	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/internal/callsites"
 )
 
 // userdataEntry contains the passed data and some metadata
@@ -12,57 +18,103 @@ type userdataEntry struct {
 	data any
 	// once tells the lookup to delete the entry after it is used
 	once bool
+	// chunk is the chunk of the pointer, it is returned to the heap of the chunk when the entry is deleted
+	chunk *chunk
+}
+
+// numShards is the number of independently locked parts of the registry. The shard of a pointer
+// is selected by its low bits, consecutive pointers of a chunk use different shards.
+const numShards = 64
+
+// shard contains the entries of the pointers whose low bits select the shard.
+type shard struct {
+	mu      sync.Mutex
+	entries map[unsafe.Pointer]userdataEntry
+	// sites maps the pointers of the entries to the program counter of the call site that registered
+	// them. It is only used in debug mode, it is not part of the entry to keep the entries small.
+	sites map[unsafe.Pointer]uintptr
+	quarantine
+
+	// pad the shard to its own cache lines
+	_ [64]byte
+}
+
+var shards [numShards]shard
+
+func init() {
+	for i := range shards {
+		shards[i].entries = make(map[unsafe.Pointer]userdataEntry)
+		shards[i].sites = make(map[unsafe.Pointer]uintptr)
+	}
 }
 
-var userdataLock sync.Mutex
-var userdataRegistry map[unsafe.Pointer]userdataEntry = make(map[unsafe.Pointer]userdataEntry)
+// shardOf returns the shard that contains the entry of cpointer.
+func shardOf(cpointer unsafe.Pointer) *shard {
+	return &shards[uintptr(cpointer)%numShards]
+}
+
+// register is called by Register and RegisterOnce, the call site is the first caller of these
+// that is not a generated binding.
+func register(data any, once bool) unsafe.Pointer {
+	var site uintptr
+	if callsites.Debug {
+		site = callsites.CallerOutsideGenerated(2)
+	}
+
+	h := lockHeap()
+	cpointer, c := h.getPointer()
+	h.mu.Unlock()
 
-func register(cpointer unsafe.Pointer, data any, once bool) {
-	userdataLock.Lock()
-	defer userdataLock.Unlock()
+	s := shardOf(cpointer)
 
-	if _, ok := userdataRegistry[cpointer]; ok {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.entries[cpointer]; ok {
 		panic("given pointer is already registered")
 	}
 
-	userdataRegistry[cpointer] = userdataEntry{
-		data: data,
-		once: once,
+	s.entries[cpointer] = userdataEntry{
+		data:  data,
+		once:  once,
+		chunk: c,
+	}
+
+	if callsites.Debug {
+		s.sites[cpointer] = site
+		profile.Add(cpointer, 2)
 	}
+
+	return cpointer
 }
 
 // Register registers the given userdata and returns a valid C pointer
 // that can be passed to C code.
 func Register(data any) unsafe.Pointer {
-	ptr := getPointer()
-
-	register(ptr, data, false)
-
-	return ptr
+	return register(data, false)
 }
 
 // RegisterOnce registers the given userdata and returns a valid C pointer
 // that can be passed to C code.
 // The userdata will be deleted after it is used.
 func RegisterOnce(data any) unsafe.Pointer {
-	ptr := getPointer()
-
-	register(ptr, data, true)
-
-	return ptr
+	return register(data, true)
 }
 
 func Load(cpointer unsafe.Pointer) any {
-	userdataLock.Lock()
-	defer userdataLock.Unlock()
+	s := shardOf(cpointer)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	fs, ok := userdataRegistry[cpointer]
+	fs, ok := s.entries[cpointer]
 	if !ok {
+		s.check(cpointer, "load")
 		return nil
 	}
 
 	if fs.once {
-		deleteUnlocked(cpointer)
+		s.deleteUnlocked(cpointer)
 	}
 
 	return fs.data
@@ -70,20 +122,44 @@ func Load(cpointer unsafe.Pointer) any {
 
 // Delete deletes the given userdata
 func Delete(cpointer unsafe.Pointer) {
-	userdataLock.Lock()
-	defer userdataLock.Unlock()
+	s := shardOf(cpointer)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	deleteUnlocked(cpointer)
+	s.deleteUnlocked(cpointer)
 }
 
-func deleteUnlocked(cpointer unsafe.Pointer) {
-	_, ok := userdataRegistry[cpointer]
+// deleteUnlocked deletes the entry of cpointer and returns the pointer to the heap of its chunk.
+// The lock of the shard must be held, the lock of the heap is taken after it.
+func (s *shard) deleteUnlocked(cpointer unsafe.Pointer) {
+	entry, ok := s.entries[cpointer]
 
 	if !ok {
+		s.check(cpointer, "delete")
 		panic("no userdata for given pointer")
 	}
 
-	delete(userdataRegistry, cpointer)
+	delete(s.entries, cpointer)
+
+	if callsites.Debug {
+		profile.Remove(cpointer)
+
+		site := s.sites[cpointer]
+		delete(s.sites, cpointer)
+
+		reuse, ok := s.add(cpointer, entry.chunk, site)
+		if !ok {
+			return
+		}
+
+		cpointer, entry.chunk = reuse.ptr, reuse.chunk
+	}
+
+	h := entry.chunk.owner
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
 
-	returnPointer(cpointer)
+	h.returnPointer(entry.chunk, cpointer)
 }
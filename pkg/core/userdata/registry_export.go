@@ -1,11 +1,17 @@
 package userdata
 
-import "unsafe"
+import (
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core"
+)
 
 // #cgo CFLAGS: -Wno-deprecated-declarations
 import "C"
 
 //export destroyUserdata
 func destroyUserdata(ptr unsafe.Pointer) {
+	defer core.RecoverCallback("destroyUserdata")
+
 	Delete(ptr)
 }
@@ -0,0 +1,38 @@
+package userdata
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/internal/callsites"
+)
+
+func TestStats(t *testing.T) {
+	callsites.Debug = true
+	defer func() { callsites.Debug = false }()
+
+	ptrs := make([]unsafe.Pointer, 0, 3)
+
+	for range 3 {
+		ptrs = append(ptrs, Register(1))
+	}
+
+	defer func() {
+		for _, p := range ptrs {
+			Delete(p)
+		}
+	}()
+
+	for _, s := range Stats() {
+		if strings.HasSuffix(s.Function, ".TestStats") {
+			if s.Count != 3 {
+				t.Fatalf("Expected 3 registrations for %s, got %d", s.Function, s.Count)
+			}
+
+			return
+		}
+	}
+
+	t.Fatalf("Expected the call site of the test in the stats, got %v", Stats())
+}
@@ -0,0 +1,127 @@
+package gio
+
+import (
+	"context"
+	"sync"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/userdata"
+)
+
+// #include <gio/gio.h>
+// extern void _goglib_gio2_cancellable_cancelled(GCancellable*, gpointer);
+// extern void _goglib_gio2_cancellable_finalized(gpointer, GObject*);
+// extern void destroyUserdata(gpointer);
+import "C"
+
+// cancellableLink forwards the cancellation of a context.Context to a GCancellable
+// for as long as the GCancellable is alive.
+type cancellableLink struct {
+	mu     sync.Mutex
+	native *C.GCancellable
+	stop   func() bool
+}
+
+// cancel cancels the linked GCancellable if it is still alive. The lock is not held while
+// cancelling, because the "cancelled" handlers may drop the last reference to the cancellable.
+func (l *cancellableLink) cancel() {
+	l.mu.Lock()
+	native := l.native
+	if native != nil {
+		C.g_object_ref(C.gpointer(native))
+	}
+	l.mu.Unlock()
+
+	if native == nil {
+		return
+	}
+
+	C.g_cancellable_cancel(native)
+	C.g_object_unref(C.gpointer(native))
+}
+
+// finalized is called when the GCancellable is finalized and unhooks the context.
+func (l *cancellableLink) finalized() {
+	l.mu.Lock()
+	l.native = nil
+	l.mu.Unlock()
+
+	l.stop()
+}
+
+// UnsafeGCancellableToGlibNone creates a GCancellable that is cancelled when ctx is done. A nil
+// pointer is returned for contexts that can never be cancelled, which glib treats as "not cancellable".
+//
+// The returned GCancellable holds a reference that must be released with UnsafeGCancellableRelease
+// once the call that uses it returned. Asynchronous operations take their own reference, so the
+// cancellation keeps working until they finish.
+//
+// GCancellable is not bound as a class, the generated functions take a context.Context and convert it
+// with this function for every call. Use it directly to get a real GCancellable object, e.g. to pass it
+// to C code or to share one cancellable between several calls:
+//
+//	native := gio.UnsafeGCancellableToGlibNone(ctx) // a *C.GCancellable, or nil
+//	defer gio.UnsafeGCancellableRelease(native)
+//
+// The object can also be cancelled with g_cancellable_cancel, this does not cancel ctx. A GCancellable
+// that is received from C is converted with [NewCancellableContext].
+func UnsafeGCancellableToGlibNone(ctx context.Context) unsafe.Pointer {
+	if ctx == nil || ctx.Done() == nil {
+		return nil
+	}
+
+	native := C.g_cancellable_new()
+
+	if ctx.Err() != nil {
+		C.g_cancellable_cancel(native)
+
+		return unsafe.Pointer(native)
+	}
+
+	link := &cancellableLink{
+		native: native,
+	}
+
+	link.stop = context.AfterFunc(ctx, link.cancel)
+
+	C.g_object_weak_ref(
+		(*C.GObject)(unsafe.Pointer(native)),
+		(C.GWeakNotify)((*[0]byte)(C._goglib_gio2_cancellable_finalized)),
+		C.gpointer(userdata.Register(link)),
+	)
+
+	return unsafe.Pointer(native)
+}
+
+// UnsafeGCancellableRelease releases the reference returned by UnsafeGCancellableToGlibNone.
+// It is safe to call with a nil pointer.
+func UnsafeGCancellableRelease(c unsafe.Pointer) {
+	if c == nil {
+		return
+	}
+
+	C.g_object_unref(C.gpointer(c))
+}
+
+// NewCancellableContext returns a context.Context that is cancelled when the given GCancellable
+// is cancelled. A nil GCancellable results in a context that is never cancelled.
+//
+// see also https://docs.gtk.org/gio/method.Cancellable.connect.html
+func NewCancellableContext(c unsafe.Pointer) context.Context {
+	if c == nil {
+		return context.Background()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// the handler is invoked right away if the cancellable is already cancelled. The
+	// cancel func is released by glib when the cancellable is finalized.
+	C.g_cancellable_connect(
+		(*C.GCancellable)(c),
+		(C.GCallback)((*[0]byte)(C._goglib_gio2_cancellable_cancelled)),
+		C.gpointer(userdata.Register(cancel)),
+		(C.GDestroyNotify)((*[0]byte)(C.destroyUserdata)),
+	)
+
+	return ctx
+}
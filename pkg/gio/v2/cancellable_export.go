@@ -0,0 +1,31 @@
+package gio
+
+import (
+	"context"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core"
+	"github.com/go-gst/go-glib/pkg/core/userdata"
+)
+
+// #include <gio/gio.h>
+import "C"
+
+//export _goglib_gio2_cancellable_cancelled
+func _goglib_gio2_cancellable_cancelled(_ *C.GCancellable, data C.gpointer) {
+	defer core.RecoverCallback("_goglib_gio2_cancellable_cancelled")
+
+	cancel := userdata.Load(unsafe.Pointer(data)).(context.CancelFunc)
+
+	cancel()
+}
+
+//export _goglib_gio2_cancellable_finalized
+func _goglib_gio2_cancellable_finalized(data C.gpointer, _ *C.GObject) {
+	defer core.RecoverCallback("_goglib_gio2_cancellable_finalized")
+
+	link := userdata.Load(unsafe.Pointer(data)).(*cancellableLink)
+	userdata.Delete(unsafe.Pointer(data))
+
+	link.finalized()
+}
@@ -5,6 +5,7 @@ package gio
 import (
 	"unsafe"
 
+	"github.com/go-gst/go-glib/pkg/core"
 	"github.com/go-gst/go-glib/pkg/core/classdata"
 	"github.com/go-gst/go-glib/pkg/core/userdata"
 	"github.com/go-gst/go-glib/pkg/gobject/v2"
@@ -15,6 +16,8 @@ import "C"
 
 //export _goglib_gio2_AsyncReadyCallback
 func _goglib_gio2_AsyncReadyCallback(carg1 *C.GObject, carg2 *C.GAsyncResult, carg3 C.gpointer) {
+	defer core.RecoverCallback("_goglib_gio2_AsyncReadyCallback")
+
 	var fn AsyncReadyCallback
 	{
 		v := userdata.Load(unsafe.Pointer(carg3))
@@ -37,6 +40,8 @@ func _goglib_gio2_AsyncReadyCallback(carg1 *C.GObject, carg2 *C.GAsyncResult, ca
 
 //export _goglib_gio2_VfsFileLookupFunc
 func _goglib_gio2_VfsFileLookupFunc(carg1 *C.GVfs, carg2 *C.char, carg3 C.gpointer) (cret *C.GFile) {
+	defer core.RecoverCallback("_goglib_gio2_VfsFileLookupFunc")
+
 	var fn VfsFileLookupFunc
 	{
 		v := userdata.Load(unsafe.Pointer(carg3))
@@ -64,6 +69,8 @@ func _goglib_gio2_VfsFileLookupFunc(carg1 *C.GVfs, carg2 *C.char, carg3 C.gpoint
 
 //export _goglib_gio2_AppLaunchContext_launch_failed
 func _goglib_gio2_AppLaunchContext_launch_failed(carg0 *C.GAppLaunchContext, carg1 *C.char) {
+	defer core.RecoverCallback("_goglib_gio2_AppLaunchContext_launch_failed")
+
 	var fn func(carg0 *C.GAppLaunchContext, carg1 *C.char)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_AppLaunchContext_launch_failed").(func(carg0 *C.GAppLaunchContext, carg1 *C.char))
@@ -76,6 +83,8 @@ func _goglib_gio2_AppLaunchContext_launch_failed(carg0 *C.GAppLaunchContext, car
 
 //export _goglib_gio2_Application_activate
 func _goglib_gio2_Application_activate(carg0 *C.GApplication) {
+	defer core.RecoverCallback("_goglib_gio2_Application_activate")
+
 	var fn func(carg0 *C.GApplication)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_activate").(func(carg0 *C.GApplication))
@@ -88,6 +97,8 @@ func _goglib_gio2_Application_activate(carg0 *C.GApplication) {
 
 //export _goglib_gio2_Application_add_platform_data
 func _goglib_gio2_Application_add_platform_data(carg0 *C.GApplication, carg1 *C.GVariantBuilder) {
+	defer core.RecoverCallback("_goglib_gio2_Application_add_platform_data")
+
 	var fn func(carg0 *C.GApplication, carg1 *C.GVariantBuilder)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_add_platform_data").(func(carg0 *C.GApplication, carg1 *C.GVariantBuilder))
@@ -100,6 +111,8 @@ func _goglib_gio2_Application_add_platform_data(carg0 *C.GApplication, carg1 *C.
 
 //export _goglib_gio2_Application_command_line
 func _goglib_gio2_Application_command_line(carg0 *C.GApplication, carg1 *C.GApplicationCommandLine) (cret C.int) {
+	defer core.RecoverCallback("_goglib_gio2_Application_command_line")
+
 	var fn func(carg0 *C.GApplication, carg1 *C.GApplicationCommandLine) (cret C.int)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_command_line").(func(carg0 *C.GApplication, carg1 *C.GApplicationCommandLine) (cret C.int))
@@ -112,6 +125,8 @@ func _goglib_gio2_Application_command_line(carg0 *C.GApplication, carg1 *C.GAppl
 
 //export _goglib_gio2_Application_handle_local_options
 func _goglib_gio2_Application_handle_local_options(carg0 *C.GApplication, carg1 *C.GVariantDict) (cret C.gint) {
+	defer core.RecoverCallback("_goglib_gio2_Application_handle_local_options")
+
 	var fn func(carg0 *C.GApplication, carg1 *C.GVariantDict) (cret C.gint)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_handle_local_options").(func(carg0 *C.GApplication, carg1 *C.GVariantDict) (cret C.gint))
@@ -124,6 +139,8 @@ func _goglib_gio2_Application_handle_local_options(carg0 *C.GApplication, carg1
 
 //export _goglib_gio2_Application_name_lost
 func _goglib_gio2_Application_name_lost(carg0 *C.GApplication) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_Application_name_lost")
+
 	var fn func(carg0 *C.GApplication) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_name_lost").(func(carg0 *C.GApplication) (cret C.gboolean))
@@ -136,6 +153,8 @@ func _goglib_gio2_Application_name_lost(carg0 *C.GApplication) (cret C.gboolean)
 
 //export _goglib_gio2_Application_open
 func _goglib_gio2_Application_open(carg0 *C.GApplication, carg1 **C.GFile, carg2 C.gint, carg3 *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_Application_open")
+
 	var fn func(carg0 *C.GApplication, carg1 **C.GFile, carg2 C.gint, carg3 *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_open").(func(carg0 *C.GApplication, carg1 **C.GFile, carg2 C.gint, carg3 *C.gchar))
@@ -148,6 +167,8 @@ func _goglib_gio2_Application_open(carg0 *C.GApplication, carg1 **C.GFile, carg2
 
 //export _goglib_gio2_Application_quit_mainloop
 func _goglib_gio2_Application_quit_mainloop(carg0 *C.GApplication) {
+	defer core.RecoverCallback("_goglib_gio2_Application_quit_mainloop")
+
 	var fn func(carg0 *C.GApplication)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_quit_mainloop").(func(carg0 *C.GApplication))
@@ -160,6 +181,8 @@ func _goglib_gio2_Application_quit_mainloop(carg0 *C.GApplication) {
 
 //export _goglib_gio2_Application_run_mainloop
 func _goglib_gio2_Application_run_mainloop(carg0 *C.GApplication) {
+	defer core.RecoverCallback("_goglib_gio2_Application_run_mainloop")
+
 	var fn func(carg0 *C.GApplication)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_run_mainloop").(func(carg0 *C.GApplication))
@@ -172,6 +195,8 @@ func _goglib_gio2_Application_run_mainloop(carg0 *C.GApplication) {
 
 //export _goglib_gio2_Application_shutdown
 func _goglib_gio2_Application_shutdown(carg0 *C.GApplication) {
+	defer core.RecoverCallback("_goglib_gio2_Application_shutdown")
+
 	var fn func(carg0 *C.GApplication)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_shutdown").(func(carg0 *C.GApplication))
@@ -184,6 +209,8 @@ func _goglib_gio2_Application_shutdown(carg0 *C.GApplication) {
 
 //export _goglib_gio2_Application_startup
 func _goglib_gio2_Application_startup(carg0 *C.GApplication) {
+	defer core.RecoverCallback("_goglib_gio2_Application_startup")
+
 	var fn func(carg0 *C.GApplication)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Application_startup").(func(carg0 *C.GApplication))
@@ -196,6 +223,8 @@ func _goglib_gio2_Application_startup(carg0 *C.GApplication) {
 
 //export _goglib_gio2_ApplicationCommandLine_done
 func _goglib_gio2_ApplicationCommandLine_done(carg0 *C.GApplicationCommandLine) {
+	defer core.RecoverCallback("_goglib_gio2_ApplicationCommandLine_done")
+
 	var fn func(carg0 *C.GApplicationCommandLine)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_ApplicationCommandLine_done").(func(carg0 *C.GApplicationCommandLine))
@@ -208,6 +237,8 @@ func _goglib_gio2_ApplicationCommandLine_done(carg0 *C.GApplicationCommandLine)
 
 //export _goglib_gio2_ApplicationCommandLine_get_stdin
 func _goglib_gio2_ApplicationCommandLine_get_stdin(carg0 *C.GApplicationCommandLine) (cret *C.GInputStream) {
+	defer core.RecoverCallback("_goglib_gio2_ApplicationCommandLine_get_stdin")
+
 	var fn func(carg0 *C.GApplicationCommandLine) (cret *C.GInputStream)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_ApplicationCommandLine_get_stdin").(func(carg0 *C.GApplicationCommandLine) (cret *C.GInputStream))
@@ -220,6 +251,8 @@ func _goglib_gio2_ApplicationCommandLine_get_stdin(carg0 *C.GApplicationCommandL
 
 //export _goglib_gio2_ApplicationCommandLine_print_literal
 func _goglib_gio2_ApplicationCommandLine_print_literal(carg0 *C.GApplicationCommandLine, carg1 *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_ApplicationCommandLine_print_literal")
+
 	var fn func(carg0 *C.GApplicationCommandLine, carg1 *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_ApplicationCommandLine_print_literal").(func(carg0 *C.GApplicationCommandLine, carg1 *C.gchar))
@@ -232,6 +265,8 @@ func _goglib_gio2_ApplicationCommandLine_print_literal(carg0 *C.GApplicationComm
 
 //export _goglib_gio2_ApplicationCommandLine_printerr_literal
 func _goglib_gio2_ApplicationCommandLine_printerr_literal(carg0 *C.GApplicationCommandLine, carg1 *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_ApplicationCommandLine_printerr_literal")
+
 	var fn func(carg0 *C.GApplicationCommandLine, carg1 *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_ApplicationCommandLine_printerr_literal").(func(carg0 *C.GApplicationCommandLine, carg1 *C.gchar))
@@ -244,6 +279,8 @@ func _goglib_gio2_ApplicationCommandLine_printerr_literal(carg0 *C.GApplicationC
 
 //export _goglib_gio2_Cancellable_cancelled
 func _goglib_gio2_Cancellable_cancelled(carg0 *C.GCancellable) {
+	defer core.RecoverCallback("_goglib_gio2_Cancellable_cancelled")
+
 	var fn func(carg0 *C.GCancellable)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Cancellable_cancelled").(func(carg0 *C.GCancellable))
@@ -256,6 +293,8 @@ func _goglib_gio2_Cancellable_cancelled(carg0 *C.GCancellable) {
 
 //export _goglib_gio2_FileEnumerator_close_finish
 func _goglib_gio2_FileEnumerator_close_finish(carg0 *C.GFileEnumerator, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileEnumerator_close_finish")
+
 	var fn func(carg0 *C.GFileEnumerator, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileEnumerator_close_finish").(func(carg0 *C.GFileEnumerator, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean))
@@ -268,6 +307,8 @@ func _goglib_gio2_FileEnumerator_close_finish(carg0 *C.GFileEnumerator, carg1 *C
 
 //export _goglib_gio2_FileEnumerator_close_fn
 func _goglib_gio2_FileEnumerator_close_fn(carg0 *C.GFileEnumerator, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileEnumerator_close_fn")
+
 	var fn func(carg0 *C.GFileEnumerator, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileEnumerator_close_fn").(func(carg0 *C.GFileEnumerator, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -280,6 +321,8 @@ func _goglib_gio2_FileEnumerator_close_fn(carg0 *C.GFileEnumerator, carg1 *C.GCa
 
 //export _goglib_gio2_FileEnumerator_next_file
 func _goglib_gio2_FileEnumerator_next_file(carg0 *C.GFileEnumerator, carg1 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo) {
+	defer core.RecoverCallback("_goglib_gio2_FileEnumerator_next_file")
+
 	var fn func(carg0 *C.GFileEnumerator, carg1 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileEnumerator_next_file").(func(carg0 *C.GFileEnumerator, carg1 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo))
@@ -292,6 +335,8 @@ func _goglib_gio2_FileEnumerator_next_file(carg0 *C.GFileEnumerator, carg1 *C.GC
 
 //export _goglib_gio2_FileEnumerator_next_files_finish
 func _goglib_gio2_FileEnumerator_next_files_finish(carg0 *C.GFileEnumerator, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_FileEnumerator_next_files_finish")
+
 	var fn func(carg0 *C.GFileEnumerator, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileEnumerator_next_files_finish").(func(carg0 *C.GFileEnumerator, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList))
@@ -304,6 +349,8 @@ func _goglib_gio2_FileEnumerator_next_files_finish(carg0 *C.GFileEnumerator, car
 
 //export _goglib_gio2_FileMonitor_cancel
 func _goglib_gio2_FileMonitor_cancel(carg0 *C.GFileMonitor) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileMonitor_cancel")
+
 	var fn func(carg0 *C.GFileMonitor) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileMonitor_cancel").(func(carg0 *C.GFileMonitor) (cret C.gboolean))
@@ -316,6 +363,8 @@ func _goglib_gio2_FileMonitor_cancel(carg0 *C.GFileMonitor) (cret C.gboolean) {
 
 //export _goglib_gio2_FileMonitor_changed
 func _goglib_gio2_FileMonitor_changed(carg0 *C.GFileMonitor, carg1 *C.GFile, carg2 *C.GFile, carg3 C.GFileMonitorEvent) {
+	defer core.RecoverCallback("_goglib_gio2_FileMonitor_changed")
+
 	var fn func(carg0 *C.GFileMonitor, carg1 *C.GFile, carg2 *C.GFile, carg3 C.GFileMonitorEvent)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileMonitor_changed").(func(carg0 *C.GFileMonitor, carg1 *C.GFile, carg2 *C.GFile, carg3 C.GFileMonitorEvent))
@@ -328,6 +377,8 @@ func _goglib_gio2_FileMonitor_changed(carg0 *C.GFileMonitor, carg1 *C.GFile, car
 
 //export _goglib_gio2_FilenameCompleter_got_completion_data
 func _goglib_gio2_FilenameCompleter_got_completion_data(carg0 *C.GFilenameCompleter) {
+	defer core.RecoverCallback("_goglib_gio2_FilenameCompleter_got_completion_data")
+
 	var fn func(carg0 *C.GFilenameCompleter)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FilenameCompleter_got_completion_data").(func(carg0 *C.GFilenameCompleter))
@@ -340,6 +391,8 @@ func _goglib_gio2_FilenameCompleter_got_completion_data(carg0 *C.GFilenameComple
 
 //export _goglib_gio2_IOStream_close_finish
 func _goglib_gio2_IOStream_close_finish(carg0 *C.GIOStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_IOStream_close_finish")
+
 	var fn func(carg0 *C.GIOStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_IOStream_close_finish").(func(carg0 *C.GIOStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean))
@@ -352,6 +405,8 @@ func _goglib_gio2_IOStream_close_finish(carg0 *C.GIOStream, carg1 *C.GAsyncResul
 
 //export _goglib_gio2_IOStream_close_fn
 func _goglib_gio2_IOStream_close_fn(carg0 *C.GIOStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_IOStream_close_fn")
+
 	var fn func(carg0 *C.GIOStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_IOStream_close_fn").(func(carg0 *C.GIOStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -364,6 +419,8 @@ func _goglib_gio2_IOStream_close_fn(carg0 *C.GIOStream, carg1 *C.GCancellable, _
 
 //export _goglib_gio2_IOStream_get_input_stream
 func _goglib_gio2_IOStream_get_input_stream(carg0 *C.GIOStream) (cret *C.GInputStream) {
+	defer core.RecoverCallback("_goglib_gio2_IOStream_get_input_stream")
+
 	var fn func(carg0 *C.GIOStream) (cret *C.GInputStream)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_IOStream_get_input_stream").(func(carg0 *C.GIOStream) (cret *C.GInputStream))
@@ -376,6 +433,8 @@ func _goglib_gio2_IOStream_get_input_stream(carg0 *C.GIOStream) (cret *C.GInputS
 
 //export _goglib_gio2_IOStream_get_output_stream
 func _goglib_gio2_IOStream_get_output_stream(carg0 *C.GIOStream) (cret *C.GOutputStream) {
+	defer core.RecoverCallback("_goglib_gio2_IOStream_get_output_stream")
+
 	var fn func(carg0 *C.GIOStream) (cret *C.GOutputStream)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_IOStream_get_output_stream").(func(carg0 *C.GIOStream) (cret *C.GOutputStream))
@@ -388,6 +447,8 @@ func _goglib_gio2_IOStream_get_output_stream(carg0 *C.GIOStream) (cret *C.GOutpu
 
 //export _goglib_gio2_InetAddress_to_string
 func _goglib_gio2_InetAddress_to_string(carg0 *C.GInetAddress) (cret *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_InetAddress_to_string")
+
 	var fn func(carg0 *C.GInetAddress) (cret *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_InetAddress_to_string").(func(carg0 *C.GInetAddress) (cret *C.gchar))
@@ -400,6 +461,8 @@ func _goglib_gio2_InetAddress_to_string(carg0 *C.GInetAddress) (cret *C.gchar) {
 
 //export _goglib_gio2_InputStream_close_finish
 func _goglib_gio2_InputStream_close_finish(carg0 *C.GInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_InputStream_close_finish")
+
 	var fn func(carg0 *C.GInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_InputStream_close_finish").(func(carg0 *C.GInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean))
@@ -412,6 +475,8 @@ func _goglib_gio2_InputStream_close_finish(carg0 *C.GInputStream, carg1 *C.GAsyn
 
 //export _goglib_gio2_InputStream_close_fn
 func _goglib_gio2_InputStream_close_fn(carg0 *C.GInputStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_InputStream_close_fn")
+
 	var fn func(carg0 *C.GInputStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_InputStream_close_fn").(func(carg0 *C.GInputStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -424,6 +489,8 @@ func _goglib_gio2_InputStream_close_fn(carg0 *C.GInputStream, carg1 *C.GCancella
 
 //export _goglib_gio2_InputStream_read_finish
 func _goglib_gio2_InputStream_read_finish(carg0 *C.GInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_InputStream_read_finish")
+
 	var fn func(carg0 *C.GInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_InputStream_read_finish").(func(carg0 *C.GInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize))
@@ -436,6 +503,8 @@ func _goglib_gio2_InputStream_read_finish(carg0 *C.GInputStream, carg1 *C.GAsync
 
 //export _goglib_gio2_InputStream_skip
 func _goglib_gio2_InputStream_skip(carg0 *C.GInputStream, carg1 C.gsize, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_InputStream_skip")
+
 	var fn func(carg0 *C.GInputStream, carg1 C.gsize, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_InputStream_skip").(func(carg0 *C.GInputStream, carg1 C.gsize, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gssize))
@@ -448,6 +517,8 @@ func _goglib_gio2_InputStream_skip(carg0 *C.GInputStream, carg1 C.gsize, carg2 *
 
 //export _goglib_gio2_InputStream_skip_finish
 func _goglib_gio2_InputStream_skip_finish(carg0 *C.GInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_InputStream_skip_finish")
+
 	var fn func(carg0 *C.GInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_InputStream_skip_finish").(func(carg0 *C.GInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize))
@@ -460,6 +531,8 @@ func _goglib_gio2_InputStream_skip_finish(carg0 *C.GInputStream, carg1 *C.GAsync
 
 //export _goglib_gio2_MenuLinkIter_get_next
 func _goglib_gio2_MenuLinkIter_get_next(carg0 *C.GMenuLinkIter, carg1 **C.gchar, carg2 **C.GMenuModel) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_MenuLinkIter_get_next")
+
 	var fn func(carg0 *C.GMenuLinkIter, carg1 **C.gchar, carg2 **C.GMenuModel) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MenuLinkIter_get_next").(func(carg0 *C.GMenuLinkIter, carg1 **C.gchar, carg2 **C.GMenuModel) (cret C.gboolean))
@@ -472,6 +545,8 @@ func _goglib_gio2_MenuLinkIter_get_next(carg0 *C.GMenuLinkIter, carg1 **C.gchar,
 
 //export _goglib_gio2_MenuModel_get_item_link
 func _goglib_gio2_MenuModel_get_item_link(carg0 *C.GMenuModel, carg1 C.gint, carg2 *C.gchar) (cret *C.GMenuModel) {
+	defer core.RecoverCallback("_goglib_gio2_MenuModel_get_item_link")
+
 	var fn func(carg0 *C.GMenuModel, carg1 C.gint, carg2 *C.gchar) (cret *C.GMenuModel)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MenuModel_get_item_link").(func(carg0 *C.GMenuModel, carg1 C.gint, carg2 *C.gchar) (cret *C.GMenuModel))
@@ -484,6 +559,8 @@ func _goglib_gio2_MenuModel_get_item_link(carg0 *C.GMenuModel, carg1 C.gint, car
 
 //export _goglib_gio2_MenuModel_get_n_items
 func _goglib_gio2_MenuModel_get_n_items(carg0 *C.GMenuModel) (cret C.gint) {
+	defer core.RecoverCallback("_goglib_gio2_MenuModel_get_n_items")
+
 	var fn func(carg0 *C.GMenuModel) (cret C.gint)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MenuModel_get_n_items").(func(carg0 *C.GMenuModel) (cret C.gint))
@@ -496,6 +573,8 @@ func _goglib_gio2_MenuModel_get_n_items(carg0 *C.GMenuModel) (cret C.gint) {
 
 //export _goglib_gio2_MenuModel_is_mutable
 func _goglib_gio2_MenuModel_is_mutable(carg0 *C.GMenuModel) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_MenuModel_is_mutable")
+
 	var fn func(carg0 *C.GMenuModel) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MenuModel_is_mutable").(func(carg0 *C.GMenuModel) (cret C.gboolean))
@@ -508,6 +587,8 @@ func _goglib_gio2_MenuModel_is_mutable(carg0 *C.GMenuModel) (cret C.gboolean) {
 
 //export _goglib_gio2_MenuModel_iterate_item_attributes
 func _goglib_gio2_MenuModel_iterate_item_attributes(carg0 *C.GMenuModel, carg1 C.gint) (cret *C.GMenuAttributeIter) {
+	defer core.RecoverCallback("_goglib_gio2_MenuModel_iterate_item_attributes")
+
 	var fn func(carg0 *C.GMenuModel, carg1 C.gint) (cret *C.GMenuAttributeIter)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MenuModel_iterate_item_attributes").(func(carg0 *C.GMenuModel, carg1 C.gint) (cret *C.GMenuAttributeIter))
@@ -520,6 +601,8 @@ func _goglib_gio2_MenuModel_iterate_item_attributes(carg0 *C.GMenuModel, carg1 C
 
 //export _goglib_gio2_MenuModel_iterate_item_links
 func _goglib_gio2_MenuModel_iterate_item_links(carg0 *C.GMenuModel, carg1 C.gint) (cret *C.GMenuLinkIter) {
+	defer core.RecoverCallback("_goglib_gio2_MenuModel_iterate_item_links")
+
 	var fn func(carg0 *C.GMenuModel, carg1 C.gint) (cret *C.GMenuLinkIter)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MenuModel_iterate_item_links").(func(carg0 *C.GMenuModel, carg1 C.gint) (cret *C.GMenuLinkIter))
@@ -532,6 +615,8 @@ func _goglib_gio2_MenuModel_iterate_item_links(carg0 *C.GMenuModel, carg1 C.gint
 
 //export _goglib_gio2_MountOperation_aborted
 func _goglib_gio2_MountOperation_aborted(carg0 *C.GMountOperation) {
+	defer core.RecoverCallback("_goglib_gio2_MountOperation_aborted")
+
 	var fn func(carg0 *C.GMountOperation)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MountOperation_aborted").(func(carg0 *C.GMountOperation))
@@ -544,6 +629,8 @@ func _goglib_gio2_MountOperation_aborted(carg0 *C.GMountOperation) {
 
 //export _goglib_gio2_MountOperation_ask_password
 func _goglib_gio2_MountOperation_ask_password(carg0 *C.GMountOperation, carg1 *C.char, carg2 *C.char, carg3 *C.char, carg4 C.GAskPasswordFlags) {
+	defer core.RecoverCallback("_goglib_gio2_MountOperation_ask_password")
+
 	var fn func(carg0 *C.GMountOperation, carg1 *C.char, carg2 *C.char, carg3 *C.char, carg4 C.GAskPasswordFlags)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MountOperation_ask_password").(func(carg0 *C.GMountOperation, carg1 *C.char, carg2 *C.char, carg3 *C.char, carg4 C.GAskPasswordFlags))
@@ -556,6 +643,8 @@ func _goglib_gio2_MountOperation_ask_password(carg0 *C.GMountOperation, carg1 *C
 
 //export _goglib_gio2_MountOperation_ask_question
 func _goglib_gio2_MountOperation_ask_question(carg0 *C.GMountOperation, carg1 *C.char, carg2 **C.char) {
+	defer core.RecoverCallback("_goglib_gio2_MountOperation_ask_question")
+
 	var fn func(carg0 *C.GMountOperation, carg1 *C.char, carg2 **C.char)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MountOperation_ask_question").(func(carg0 *C.GMountOperation, carg1 *C.char, carg2 **C.char))
@@ -568,6 +657,8 @@ func _goglib_gio2_MountOperation_ask_question(carg0 *C.GMountOperation, carg1 *C
 
 //export _goglib_gio2_MountOperation_reply
 func _goglib_gio2_MountOperation_reply(carg0 *C.GMountOperation, carg1 C.GMountOperationResult) {
+	defer core.RecoverCallback("_goglib_gio2_MountOperation_reply")
+
 	var fn func(carg0 *C.GMountOperation, carg1 C.GMountOperationResult)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MountOperation_reply").(func(carg0 *C.GMountOperation, carg1 C.GMountOperationResult))
@@ -580,6 +671,8 @@ func _goglib_gio2_MountOperation_reply(carg0 *C.GMountOperation, carg1 C.GMountO
 
 //export _goglib_gio2_MountOperation_show_unmount_progress
 func _goglib_gio2_MountOperation_show_unmount_progress(carg0 *C.GMountOperation, carg1 *C.gchar, carg2 C.gint64, carg3 C.gint64) {
+	defer core.RecoverCallback("_goglib_gio2_MountOperation_show_unmount_progress")
+
 	var fn func(carg0 *C.GMountOperation, carg1 *C.gchar, carg2 C.gint64, carg3 C.gint64)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_MountOperation_show_unmount_progress").(func(carg0 *C.GMountOperation, carg1 *C.gchar, carg2 C.gint64, carg3 C.gint64))
@@ -592,6 +685,8 @@ func _goglib_gio2_MountOperation_show_unmount_progress(carg0 *C.GMountOperation,
 
 //export _goglib_gio2_OutputStream_close_finish
 func _goglib_gio2_OutputStream_close_finish(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_close_finish")
+
 	var fn func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_close_finish").(func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean))
@@ -604,6 +699,8 @@ func _goglib_gio2_OutputStream_close_finish(carg0 *C.GOutputStream, carg1 *C.GAs
 
 //export _goglib_gio2_OutputStream_close_fn
 func _goglib_gio2_OutputStream_close_fn(carg0 *C.GOutputStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_close_fn")
+
 	var fn func(carg0 *C.GOutputStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_close_fn").(func(carg0 *C.GOutputStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -616,6 +713,8 @@ func _goglib_gio2_OutputStream_close_fn(carg0 *C.GOutputStream, carg1 *C.GCancel
 
 //export _goglib_gio2_OutputStream_flush
 func _goglib_gio2_OutputStream_flush(carg0 *C.GOutputStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_flush")
+
 	var fn func(carg0 *C.GOutputStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_flush").(func(carg0 *C.GOutputStream, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -628,6 +727,8 @@ func _goglib_gio2_OutputStream_flush(carg0 *C.GOutputStream, carg1 *C.GCancellab
 
 //export _goglib_gio2_OutputStream_flush_finish
 func _goglib_gio2_OutputStream_flush_finish(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_flush_finish")
+
 	var fn func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_flush_finish").(func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean))
@@ -640,6 +741,8 @@ func _goglib_gio2_OutputStream_flush_finish(carg0 *C.GOutputStream, carg1 *C.GAs
 
 //export _goglib_gio2_OutputStream_splice
 func _goglib_gio2_OutputStream_splice(carg0 *C.GOutputStream, carg1 *C.GInputStream, carg2 C.GOutputStreamSpliceFlags, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_splice")
+
 	var fn func(carg0 *C.GOutputStream, carg1 *C.GInputStream, carg2 C.GOutputStreamSpliceFlags, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_splice").(func(carg0 *C.GOutputStream, carg1 *C.GInputStream, carg2 C.GOutputStreamSpliceFlags, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gssize))
@@ -652,6 +755,8 @@ func _goglib_gio2_OutputStream_splice(carg0 *C.GOutputStream, carg1 *C.GInputStr
 
 //export _goglib_gio2_OutputStream_splice_finish
 func _goglib_gio2_OutputStream_splice_finish(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_splice_finish")
+
 	var fn func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_splice_finish").(func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize))
@@ -664,6 +769,8 @@ func _goglib_gio2_OutputStream_splice_finish(carg0 *C.GOutputStream, carg1 *C.GA
 
 //export _goglib_gio2_OutputStream_write_finish
 func _goglib_gio2_OutputStream_write_finish(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_write_finish")
+
 	var fn func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_write_finish").(func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize))
@@ -676,6 +783,8 @@ func _goglib_gio2_OutputStream_write_finish(carg0 *C.GOutputStream, carg1 *C.GAs
 
 //export _goglib_gio2_OutputStream_write_fn
 func _goglib_gio2_OutputStream_write_fn(carg0 *C.GOutputStream, carg1 unsafe.Pointer, carg2 C.gsize, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_write_fn")
+
 	var fn func(carg0 *C.GOutputStream, carg1 unsafe.Pointer, carg2 C.gsize, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_write_fn").(func(carg0 *C.GOutputStream, carg1 unsafe.Pointer, carg2 C.gsize, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gssize))
@@ -688,6 +797,8 @@ func _goglib_gio2_OutputStream_write_fn(carg0 *C.GOutputStream, carg1 unsafe.Poi
 
 //export _goglib_gio2_OutputStream_writev_finish
 func _goglib_gio2_OutputStream_writev_finish(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, carg2 *C.gsize, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_writev_finish")
+
 	var fn func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, carg2 *C.gsize, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_writev_finish").(func(carg0 *C.GOutputStream, carg1 *C.GAsyncResult, carg2 *C.gsize, _cerr **C.GError) (cret C.gboolean))
@@ -700,6 +811,8 @@ func _goglib_gio2_OutputStream_writev_finish(carg0 *C.GOutputStream, carg1 *C.GA
 
 //export _goglib_gio2_OutputStream_writev_fn
 func _goglib_gio2_OutputStream_writev_fn(carg0 *C.GOutputStream, carg1 *C.GOutputVector, carg2 C.gsize, carg3 *C.gsize, carg4 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_OutputStream_writev_fn")
+
 	var fn func(carg0 *C.GOutputStream, carg1 *C.GOutputVector, carg2 C.gsize, carg3 *C.gsize, carg4 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_OutputStream_writev_fn").(func(carg0 *C.GOutputStream, carg1 *C.GOutputVector, carg2 C.gsize, carg3 *C.gsize, carg4 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -712,6 +825,8 @@ func _goglib_gio2_OutputStream_writev_fn(carg0 *C.GOutputStream, carg1 *C.GOutpu
 
 //export _goglib_gio2_Permission_acquire
 func _goglib_gio2_Permission_acquire(carg0 *C.GPermission, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_Permission_acquire")
+
 	var fn func(carg0 *C.GPermission, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Permission_acquire").(func(carg0 *C.GPermission, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -724,6 +839,8 @@ func _goglib_gio2_Permission_acquire(carg0 *C.GPermission, carg1 *C.GCancellable
 
 //export _goglib_gio2_Permission_acquire_finish
 func _goglib_gio2_Permission_acquire_finish(carg0 *C.GPermission, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_Permission_acquire_finish")
+
 	var fn func(carg0 *C.GPermission, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Permission_acquire_finish").(func(carg0 *C.GPermission, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean))
@@ -736,6 +853,8 @@ func _goglib_gio2_Permission_acquire_finish(carg0 *C.GPermission, carg1 *C.GAsyn
 
 //export _goglib_gio2_Permission_release
 func _goglib_gio2_Permission_release(carg0 *C.GPermission, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_Permission_release")
+
 	var fn func(carg0 *C.GPermission, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Permission_release").(func(carg0 *C.GPermission, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -748,6 +867,8 @@ func _goglib_gio2_Permission_release(carg0 *C.GPermission, carg1 *C.GCancellable
 
 //export _goglib_gio2_Permission_release_finish
 func _goglib_gio2_Permission_release_finish(carg0 *C.GPermission, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_Permission_release_finish")
+
 	var fn func(carg0 *C.GPermission, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Permission_release_finish").(func(carg0 *C.GPermission, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean))
@@ -760,6 +881,8 @@ func _goglib_gio2_Permission_release_finish(carg0 *C.GPermission, carg1 *C.GAsyn
 
 //export _goglib_gio2_Resolver_lookup_by_address
 func _goglib_gio2_Resolver_lookup_by_address(carg0 *C.GResolver, carg1 *C.GInetAddress, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_Resolver_lookup_by_address")
+
 	var fn func(carg0 *C.GResolver, carg1 *C.GInetAddress, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Resolver_lookup_by_address").(func(carg0 *C.GResolver, carg1 *C.GInetAddress, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.gchar))
@@ -772,6 +895,8 @@ func _goglib_gio2_Resolver_lookup_by_address(carg0 *C.GResolver, carg1 *C.GInetA
 
 //export _goglib_gio2_Resolver_lookup_by_address_finish
 func _goglib_gio2_Resolver_lookup_by_address_finish(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_Resolver_lookup_by_address_finish")
+
 	var fn func(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Resolver_lookup_by_address_finish").(func(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.gchar))
@@ -784,6 +909,8 @@ func _goglib_gio2_Resolver_lookup_by_address_finish(carg0 *C.GResolver, carg1 *C
 
 //export _goglib_gio2_Resolver_lookup_by_name
 func _goglib_gio2_Resolver_lookup_by_name(carg0 *C.GResolver, carg1 *C.gchar, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_Resolver_lookup_by_name")
+
 	var fn func(carg0 *C.GResolver, carg1 *C.gchar, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Resolver_lookup_by_name").(func(carg0 *C.GResolver, carg1 *C.gchar, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GList))
@@ -796,6 +923,8 @@ func _goglib_gio2_Resolver_lookup_by_name(carg0 *C.GResolver, carg1 *C.gchar, ca
 
 //export _goglib_gio2_Resolver_lookup_by_name_finish
 func _goglib_gio2_Resolver_lookup_by_name_finish(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_Resolver_lookup_by_name_finish")
+
 	var fn func(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Resolver_lookup_by_name_finish").(func(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList))
@@ -808,6 +937,8 @@ func _goglib_gio2_Resolver_lookup_by_name_finish(carg0 *C.GResolver, carg1 *C.GA
 
 //export _goglib_gio2_Resolver_lookup_by_name_with_flags
 func _goglib_gio2_Resolver_lookup_by_name_with_flags(carg0 *C.GResolver, carg1 *C.gchar, carg2 C.GResolverNameLookupFlags, carg3 *C.GCancellable, _cerr **C.GError) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_Resolver_lookup_by_name_with_flags")
+
 	var fn func(carg0 *C.GResolver, carg1 *C.gchar, carg2 C.GResolverNameLookupFlags, carg3 *C.GCancellable, _cerr **C.GError) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Resolver_lookup_by_name_with_flags").(func(carg0 *C.GResolver, carg1 *C.gchar, carg2 C.GResolverNameLookupFlags, carg3 *C.GCancellable, _cerr **C.GError) (cret *C.GList))
@@ -820,6 +951,8 @@ func _goglib_gio2_Resolver_lookup_by_name_with_flags(carg0 *C.GResolver, carg1 *
 
 //export _goglib_gio2_Resolver_lookup_by_name_with_flags_finish
 func _goglib_gio2_Resolver_lookup_by_name_with_flags_finish(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_Resolver_lookup_by_name_with_flags_finish")
+
 	var fn func(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Resolver_lookup_by_name_with_flags_finish").(func(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList))
@@ -832,6 +965,8 @@ func _goglib_gio2_Resolver_lookup_by_name_with_flags_finish(carg0 *C.GResolver,
 
 //export _goglib_gio2_Resolver_lookup_service_finish
 func _goglib_gio2_Resolver_lookup_service_finish(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_Resolver_lookup_service_finish")
+
 	var fn func(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Resolver_lookup_service_finish").(func(carg0 *C.GResolver, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList))
@@ -844,6 +979,8 @@ func _goglib_gio2_Resolver_lookup_service_finish(carg0 *C.GResolver, carg1 *C.GA
 
 //export _goglib_gio2_Resolver_reload
 func _goglib_gio2_Resolver_reload(carg0 *C.GResolver) {
+	defer core.RecoverCallback("_goglib_gio2_Resolver_reload")
+
 	var fn func(carg0 *C.GResolver)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Resolver_reload").(func(carg0 *C.GResolver))
@@ -856,6 +993,8 @@ func _goglib_gio2_Resolver_reload(carg0 *C.GResolver) {
 
 //export _goglib_gio2_Settings_change_event
 func _goglib_gio2_Settings_change_event(carg0 *C.GSettings, carg1 *C.GQuark, carg2 C.gint) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_Settings_change_event")
+
 	var fn func(carg0 *C.GSettings, carg1 *C.GQuark, carg2 C.gint) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Settings_change_event").(func(carg0 *C.GSettings, carg1 *C.GQuark, carg2 C.gint) (cret C.gboolean))
@@ -868,6 +1007,8 @@ func _goglib_gio2_Settings_change_event(carg0 *C.GSettings, carg1 *C.GQuark, car
 
 //export _goglib_gio2_Settings_changed
 func _goglib_gio2_Settings_changed(carg0 *C.GSettings, carg1 *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_Settings_changed")
+
 	var fn func(carg0 *C.GSettings, carg1 *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Settings_changed").(func(carg0 *C.GSettings, carg1 *C.gchar))
@@ -880,6 +1021,8 @@ func _goglib_gio2_Settings_changed(carg0 *C.GSettings, carg1 *C.gchar) {
 
 //export _goglib_gio2_Settings_writable_change_event
 func _goglib_gio2_Settings_writable_change_event(carg0 *C.GSettings, carg1 C.GQuark) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_Settings_writable_change_event")
+
 	var fn func(carg0 *C.GSettings, carg1 C.GQuark) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Settings_writable_change_event").(func(carg0 *C.GSettings, carg1 C.GQuark) (cret C.gboolean))
@@ -892,6 +1035,8 @@ func _goglib_gio2_Settings_writable_change_event(carg0 *C.GSettings, carg1 C.GQu
 
 //export _goglib_gio2_Settings_writable_changed
 func _goglib_gio2_Settings_writable_changed(carg0 *C.GSettings, carg1 *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_Settings_writable_changed")
+
 	var fn func(carg0 *C.GSettings, carg1 *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Settings_writable_changed").(func(carg0 *C.GSettings, carg1 *C.gchar))
@@ -904,6 +1049,8 @@ func _goglib_gio2_Settings_writable_changed(carg0 *C.GSettings, carg1 *C.gchar)
 
 //export _goglib_gio2_SocketAddress_get_family
 func _goglib_gio2_SocketAddress_get_family(carg0 *C.GSocketAddress) (cret C.GSocketFamily) {
+	defer core.RecoverCallback("_goglib_gio2_SocketAddress_get_family")
+
 	var fn func(carg0 *C.GSocketAddress) (cret C.GSocketFamily)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketAddress_get_family").(func(carg0 *C.GSocketAddress) (cret C.GSocketFamily))
@@ -916,6 +1063,8 @@ func _goglib_gio2_SocketAddress_get_family(carg0 *C.GSocketAddress) (cret C.GSoc
 
 //export _goglib_gio2_SocketAddress_get_native_size
 func _goglib_gio2_SocketAddress_get_native_size(carg0 *C.GSocketAddress) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_SocketAddress_get_native_size")
+
 	var fn func(carg0 *C.GSocketAddress) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketAddress_get_native_size").(func(carg0 *C.GSocketAddress) (cret C.gssize))
@@ -928,6 +1077,8 @@ func _goglib_gio2_SocketAddress_get_native_size(carg0 *C.GSocketAddress) (cret C
 
 //export _goglib_gio2_SocketAddressEnumerator_next
 func _goglib_gio2_SocketAddressEnumerator_next(carg0 *C.GSocketAddressEnumerator, carg1 *C.GCancellable, _cerr **C.GError) (cret *C.GSocketAddress) {
+	defer core.RecoverCallback("_goglib_gio2_SocketAddressEnumerator_next")
+
 	var fn func(carg0 *C.GSocketAddressEnumerator, carg1 *C.GCancellable, _cerr **C.GError) (cret *C.GSocketAddress)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketAddressEnumerator_next").(func(carg0 *C.GSocketAddressEnumerator, carg1 *C.GCancellable, _cerr **C.GError) (cret *C.GSocketAddress))
@@ -940,6 +1091,8 @@ func _goglib_gio2_SocketAddressEnumerator_next(carg0 *C.GSocketAddressEnumerator
 
 //export _goglib_gio2_SocketAddressEnumerator_next_finish
 func _goglib_gio2_SocketAddressEnumerator_next_finish(carg0 *C.GSocketAddressEnumerator, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GSocketAddress) {
+	defer core.RecoverCallback("_goglib_gio2_SocketAddressEnumerator_next_finish")
+
 	var fn func(carg0 *C.GSocketAddressEnumerator, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GSocketAddress)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketAddressEnumerator_next_finish").(func(carg0 *C.GSocketAddressEnumerator, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GSocketAddress))
@@ -952,6 +1105,8 @@ func _goglib_gio2_SocketAddressEnumerator_next_finish(carg0 *C.GSocketAddressEnu
 
 //export _goglib_gio2_SocketClient_event
 func _goglib_gio2_SocketClient_event(carg0 *C.GSocketClient, carg1 C.GSocketClientEvent, carg2 *C.GSocketConnectable, carg3 *C.GIOStream) {
+	defer core.RecoverCallback("_goglib_gio2_SocketClient_event")
+
 	var fn func(carg0 *C.GSocketClient, carg1 C.GSocketClientEvent, carg2 *C.GSocketConnectable, carg3 *C.GIOStream)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketClient_event").(func(carg0 *C.GSocketClient, carg1 C.GSocketClientEvent, carg2 *C.GSocketConnectable, carg3 *C.GIOStream))
@@ -964,6 +1119,8 @@ func _goglib_gio2_SocketClient_event(carg0 *C.GSocketClient, carg1 C.GSocketClie
 
 //export _goglib_gio2_SocketControlMessage_get_level
 func _goglib_gio2_SocketControlMessage_get_level(carg0 *C.GSocketControlMessage) (cret C.int) {
+	defer core.RecoverCallback("_goglib_gio2_SocketControlMessage_get_level")
+
 	var fn func(carg0 *C.GSocketControlMessage) (cret C.int)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketControlMessage_get_level").(func(carg0 *C.GSocketControlMessage) (cret C.int))
@@ -976,6 +1133,8 @@ func _goglib_gio2_SocketControlMessage_get_level(carg0 *C.GSocketControlMessage)
 
 //export _goglib_gio2_SocketControlMessage_get_size
 func _goglib_gio2_SocketControlMessage_get_size(carg0 *C.GSocketControlMessage) (cret C.gsize) {
+	defer core.RecoverCallback("_goglib_gio2_SocketControlMessage_get_size")
+
 	var fn func(carg0 *C.GSocketControlMessage) (cret C.gsize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketControlMessage_get_size").(func(carg0 *C.GSocketControlMessage) (cret C.gsize))
@@ -988,6 +1147,8 @@ func _goglib_gio2_SocketControlMessage_get_size(carg0 *C.GSocketControlMessage)
 
 //export _goglib_gio2_SocketControlMessage_get_type
 func _goglib_gio2_SocketControlMessage_get_type(carg0 *C.GSocketControlMessage) (cret C.int) {
+	defer core.RecoverCallback("_goglib_gio2_SocketControlMessage_get_type")
+
 	var fn func(carg0 *C.GSocketControlMessage) (cret C.int)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketControlMessage_get_type").(func(carg0 *C.GSocketControlMessage) (cret C.int))
@@ -1000,6 +1161,8 @@ func _goglib_gio2_SocketControlMessage_get_type(carg0 *C.GSocketControlMessage)
 
 //export _goglib_gio2_SocketListener_changed
 func _goglib_gio2_SocketListener_changed(carg0 *C.GSocketListener) {
+	defer core.RecoverCallback("_goglib_gio2_SocketListener_changed")
+
 	var fn func(carg0 *C.GSocketListener)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketListener_changed").(func(carg0 *C.GSocketListener))
@@ -1012,6 +1175,8 @@ func _goglib_gio2_SocketListener_changed(carg0 *C.GSocketListener) {
 
 //export _goglib_gio2_SocketListener_event
 func _goglib_gio2_SocketListener_event(carg0 *C.GSocketListener, carg1 C.GSocketListenerEvent, carg2 *C.GSocket) {
+	defer core.RecoverCallback("_goglib_gio2_SocketListener_event")
+
 	var fn func(carg0 *C.GSocketListener, carg1 C.GSocketListenerEvent, carg2 *C.GSocket)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketListener_event").(func(carg0 *C.GSocketListener, carg1 C.GSocketListenerEvent, carg2 *C.GSocket))
@@ -1024,6 +1189,8 @@ func _goglib_gio2_SocketListener_event(carg0 *C.GSocketListener, carg1 C.GSocket
 
 //export _goglib_gio2_SocketService_incoming
 func _goglib_gio2_SocketService_incoming(carg0 *C.GSocketService, carg1 *C.GSocketConnection, carg2 *C.GObject) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_SocketService_incoming")
+
 	var fn func(carg0 *C.GSocketService, carg1 *C.GSocketConnection, carg2 *C.GObject) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_SocketService_incoming").(func(carg0 *C.GSocketService, carg1 *C.GSocketConnection, carg2 *C.GObject) (cret C.gboolean))
@@ -1036,6 +1203,8 @@ func _goglib_gio2_SocketService_incoming(carg0 *C.GSocketService, carg1 *C.GSock
 
 //export _goglib_gio2_ThreadedSocketService_run
 func _goglib_gio2_ThreadedSocketService_run(carg0 *C.GThreadedSocketService, carg1 *C.GSocketConnection, carg2 *C.GObject) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_ThreadedSocketService_run")
+
 	var fn func(carg0 *C.GThreadedSocketService, carg1 *C.GSocketConnection, carg2 *C.GObject) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_ThreadedSocketService_run").(func(carg0 *C.GThreadedSocketService, carg1 *C.GSocketConnection, carg2 *C.GObject) (cret C.gboolean))
@@ -1048,6 +1217,8 @@ func _goglib_gio2_ThreadedSocketService_run(carg0 *C.GThreadedSocketService, car
 
 //export _goglib_gio2_TlsCertificate_verify
 func _goglib_gio2_TlsCertificate_verify(carg0 *C.GTlsCertificate, carg1 *C.GSocketConnectable, carg2 *C.GTlsCertificate) (cret C.GTlsCertificateFlags) {
+	defer core.RecoverCallback("_goglib_gio2_TlsCertificate_verify")
+
 	var fn func(carg0 *C.GTlsCertificate, carg1 *C.GSocketConnectable, carg2 *C.GTlsCertificate) (cret C.GTlsCertificateFlags)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsCertificate_verify").(func(carg0 *C.GTlsCertificate, carg1 *C.GSocketConnectable, carg2 *C.GTlsCertificate) (cret C.GTlsCertificateFlags))
@@ -1060,6 +1231,8 @@ func _goglib_gio2_TlsCertificate_verify(carg0 *C.GTlsCertificate, carg1 *C.GSock
 
 //export _goglib_gio2_TlsConnection_accept_certificate
 func _goglib_gio2_TlsConnection_accept_certificate(carg0 *C.GTlsConnection, carg1 *C.GTlsCertificate, carg2 C.GTlsCertificateFlags) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_TlsConnection_accept_certificate")
+
 	var fn func(carg0 *C.GTlsConnection, carg1 *C.GTlsCertificate, carg2 C.GTlsCertificateFlags) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsConnection_accept_certificate").(func(carg0 *C.GTlsConnection, carg1 *C.GTlsCertificate, carg2 C.GTlsCertificateFlags) (cret C.gboolean))
@@ -1072,6 +1245,8 @@ func _goglib_gio2_TlsConnection_accept_certificate(carg0 *C.GTlsConnection, carg
 
 //export _goglib_gio2_TlsConnection_get_negotiated_protocol
 func _goglib_gio2_TlsConnection_get_negotiated_protocol(carg0 *C.GTlsConnection) (cret *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_TlsConnection_get_negotiated_protocol")
+
 	var fn func(carg0 *C.GTlsConnection) (cret *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsConnection_get_negotiated_protocol").(func(carg0 *C.GTlsConnection) (cret *C.gchar))
@@ -1084,6 +1259,8 @@ func _goglib_gio2_TlsConnection_get_negotiated_protocol(carg0 *C.GTlsConnection)
 
 //export _goglib_gio2_TlsConnection_handshake
 func _goglib_gio2_TlsConnection_handshake(carg0 *C.GTlsConnection, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_TlsConnection_handshake")
+
 	var fn func(carg0 *C.GTlsConnection, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsConnection_handshake").(func(carg0 *C.GTlsConnection, carg1 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -1096,6 +1273,8 @@ func _goglib_gio2_TlsConnection_handshake(carg0 *C.GTlsConnection, carg1 *C.GCan
 
 //export _goglib_gio2_TlsConnection_handshake_finish
 func _goglib_gio2_TlsConnection_handshake_finish(carg0 *C.GTlsConnection, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_TlsConnection_handshake_finish")
+
 	var fn func(carg0 *C.GTlsConnection, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsConnection_handshake_finish").(func(carg0 *C.GTlsConnection, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gboolean))
@@ -1108,6 +1287,8 @@ func _goglib_gio2_TlsConnection_handshake_finish(carg0 *C.GTlsConnection, carg1
 
 //export _goglib_gio2_TlsDatabase_create_certificate_handle
 func _goglib_gio2_TlsDatabase_create_certificate_handle(carg0 *C.GTlsDatabase, carg1 *C.GTlsCertificate) (cret *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_TlsDatabase_create_certificate_handle")
+
 	var fn func(carg0 *C.GTlsDatabase, carg1 *C.GTlsCertificate) (cret *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsDatabase_create_certificate_handle").(func(carg0 *C.GTlsDatabase, carg1 *C.GTlsCertificate) (cret *C.gchar))
@@ -1120,6 +1301,8 @@ func _goglib_gio2_TlsDatabase_create_certificate_handle(carg0 *C.GTlsDatabase, c
 
 //export _goglib_gio2_TlsDatabase_lookup_certificate_for_handle
 func _goglib_gio2_TlsDatabase_lookup_certificate_for_handle(carg0 *C.GTlsDatabase, carg1 *C.gchar, carg2 *C.GTlsInteraction, carg3 C.GTlsDatabaseLookupFlags, carg4 *C.GCancellable, _cerr **C.GError) (cret *C.GTlsCertificate) {
+	defer core.RecoverCallback("_goglib_gio2_TlsDatabase_lookup_certificate_for_handle")
+
 	var fn func(carg0 *C.GTlsDatabase, carg1 *C.gchar, carg2 *C.GTlsInteraction, carg3 C.GTlsDatabaseLookupFlags, carg4 *C.GCancellable, _cerr **C.GError) (cret *C.GTlsCertificate)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsDatabase_lookup_certificate_for_handle").(func(carg0 *C.GTlsDatabase, carg1 *C.gchar, carg2 *C.GTlsInteraction, carg3 C.GTlsDatabaseLookupFlags, carg4 *C.GCancellable, _cerr **C.GError) (cret *C.GTlsCertificate))
@@ -1132,6 +1315,8 @@ func _goglib_gio2_TlsDatabase_lookup_certificate_for_handle(carg0 *C.GTlsDatabas
 
 //export _goglib_gio2_TlsDatabase_lookup_certificate_for_handle_finish
 func _goglib_gio2_TlsDatabase_lookup_certificate_for_handle_finish(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GTlsCertificate) {
+	defer core.RecoverCallback("_goglib_gio2_TlsDatabase_lookup_certificate_for_handle_finish")
+
 	var fn func(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GTlsCertificate)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsDatabase_lookup_certificate_for_handle_finish").(func(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GTlsCertificate))
@@ -1144,6 +1329,8 @@ func _goglib_gio2_TlsDatabase_lookup_certificate_for_handle_finish(carg0 *C.GTls
 
 //export _goglib_gio2_TlsDatabase_lookup_certificate_issuer
 func _goglib_gio2_TlsDatabase_lookup_certificate_issuer(carg0 *C.GTlsDatabase, carg1 *C.GTlsCertificate, carg2 *C.GTlsInteraction, carg3 C.GTlsDatabaseLookupFlags, carg4 *C.GCancellable, _cerr **C.GError) (cret *C.GTlsCertificate) {
+	defer core.RecoverCallback("_goglib_gio2_TlsDatabase_lookup_certificate_issuer")
+
 	var fn func(carg0 *C.GTlsDatabase, carg1 *C.GTlsCertificate, carg2 *C.GTlsInteraction, carg3 C.GTlsDatabaseLookupFlags, carg4 *C.GCancellable, _cerr **C.GError) (cret *C.GTlsCertificate)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsDatabase_lookup_certificate_issuer").(func(carg0 *C.GTlsDatabase, carg1 *C.GTlsCertificate, carg2 *C.GTlsInteraction, carg3 C.GTlsDatabaseLookupFlags, carg4 *C.GCancellable, _cerr **C.GError) (cret *C.GTlsCertificate))
@@ -1156,6 +1343,8 @@ func _goglib_gio2_TlsDatabase_lookup_certificate_issuer(carg0 *C.GTlsDatabase, c
 
 //export _goglib_gio2_TlsDatabase_lookup_certificate_issuer_finish
 func _goglib_gio2_TlsDatabase_lookup_certificate_issuer_finish(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GTlsCertificate) {
+	defer core.RecoverCallback("_goglib_gio2_TlsDatabase_lookup_certificate_issuer_finish")
+
 	var fn func(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GTlsCertificate)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsDatabase_lookup_certificate_issuer_finish").(func(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GTlsCertificate))
@@ -1168,6 +1357,8 @@ func _goglib_gio2_TlsDatabase_lookup_certificate_issuer_finish(carg0 *C.GTlsData
 
 //export _goglib_gio2_TlsDatabase_lookup_certificates_issued_by_finish
 func _goglib_gio2_TlsDatabase_lookup_certificates_issued_by_finish(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_TlsDatabase_lookup_certificates_issued_by_finish")
+
 	var fn func(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsDatabase_lookup_certificates_issued_by_finish").(func(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GList))
@@ -1180,6 +1371,8 @@ func _goglib_gio2_TlsDatabase_lookup_certificates_issued_by_finish(carg0 *C.GTls
 
 //export _goglib_gio2_TlsDatabase_verify_chain
 func _goglib_gio2_TlsDatabase_verify_chain(carg0 *C.GTlsDatabase, carg1 *C.GTlsCertificate, carg2 *C.gchar, carg3 *C.GSocketConnectable, carg4 *C.GTlsInteraction, carg5 C.GTlsDatabaseVerifyFlags, carg6 *C.GCancellable, _cerr **C.GError) (cret C.GTlsCertificateFlags) {
+	defer core.RecoverCallback("_goglib_gio2_TlsDatabase_verify_chain")
+
 	var fn func(carg0 *C.GTlsDatabase, carg1 *C.GTlsCertificate, carg2 *C.gchar, carg3 *C.GSocketConnectable, carg4 *C.GTlsInteraction, carg5 C.GTlsDatabaseVerifyFlags, carg6 *C.GCancellable, _cerr **C.GError) (cret C.GTlsCertificateFlags)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsDatabase_verify_chain").(func(carg0 *C.GTlsDatabase, carg1 *C.GTlsCertificate, carg2 *C.gchar, carg3 *C.GSocketConnectable, carg4 *C.GTlsInteraction, carg5 C.GTlsDatabaseVerifyFlags, carg6 *C.GCancellable, _cerr **C.GError) (cret C.GTlsCertificateFlags))
@@ -1192,6 +1385,8 @@ func _goglib_gio2_TlsDatabase_verify_chain(carg0 *C.GTlsDatabase, carg1 *C.GTlsC
 
 //export _goglib_gio2_TlsDatabase_verify_chain_finish
 func _goglib_gio2_TlsDatabase_verify_chain_finish(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.GTlsCertificateFlags) {
+	defer core.RecoverCallback("_goglib_gio2_TlsDatabase_verify_chain_finish")
+
 	var fn func(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.GTlsCertificateFlags)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsDatabase_verify_chain_finish").(func(carg0 *C.GTlsDatabase, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.GTlsCertificateFlags))
@@ -1204,6 +1399,8 @@ func _goglib_gio2_TlsDatabase_verify_chain_finish(carg0 *C.GTlsDatabase, carg1 *
 
 //export _goglib_gio2_TlsInteraction_ask_password
 func _goglib_gio2_TlsInteraction_ask_password(carg0 *C.GTlsInteraction, carg1 *C.GTlsPassword, carg2 *C.GCancellable, _cerr **C.GError) (cret C.GTlsInteractionResult) {
+	defer core.RecoverCallback("_goglib_gio2_TlsInteraction_ask_password")
+
 	var fn func(carg0 *C.GTlsInteraction, carg1 *C.GTlsPassword, carg2 *C.GCancellable, _cerr **C.GError) (cret C.GTlsInteractionResult)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsInteraction_ask_password").(func(carg0 *C.GTlsInteraction, carg1 *C.GTlsPassword, carg2 *C.GCancellable, _cerr **C.GError) (cret C.GTlsInteractionResult))
@@ -1216,6 +1413,8 @@ func _goglib_gio2_TlsInteraction_ask_password(carg0 *C.GTlsInteraction, carg1 *C
 
 //export _goglib_gio2_TlsInteraction_ask_password_finish
 func _goglib_gio2_TlsInteraction_ask_password_finish(carg0 *C.GTlsInteraction, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.GTlsInteractionResult) {
+	defer core.RecoverCallback("_goglib_gio2_TlsInteraction_ask_password_finish")
+
 	var fn func(carg0 *C.GTlsInteraction, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.GTlsInteractionResult)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsInteraction_ask_password_finish").(func(carg0 *C.GTlsInteraction, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.GTlsInteractionResult))
@@ -1228,6 +1427,8 @@ func _goglib_gio2_TlsInteraction_ask_password_finish(carg0 *C.GTlsInteraction, c
 
 //export _goglib_gio2_TlsInteraction_request_certificate
 func _goglib_gio2_TlsInteraction_request_certificate(carg0 *C.GTlsInteraction, carg1 *C.GTlsConnection, carg2 C.GTlsCertificateRequestFlags, carg3 *C.GCancellable, _cerr **C.GError) (cret C.GTlsInteractionResult) {
+	defer core.RecoverCallback("_goglib_gio2_TlsInteraction_request_certificate")
+
 	var fn func(carg0 *C.GTlsInteraction, carg1 *C.GTlsConnection, carg2 C.GTlsCertificateRequestFlags, carg3 *C.GCancellable, _cerr **C.GError) (cret C.GTlsInteractionResult)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsInteraction_request_certificate").(func(carg0 *C.GTlsInteraction, carg1 *C.GTlsConnection, carg2 C.GTlsCertificateRequestFlags, carg3 *C.GCancellable, _cerr **C.GError) (cret C.GTlsInteractionResult))
@@ -1240,6 +1441,8 @@ func _goglib_gio2_TlsInteraction_request_certificate(carg0 *C.GTlsInteraction, c
 
 //export _goglib_gio2_TlsInteraction_request_certificate_finish
 func _goglib_gio2_TlsInteraction_request_certificate_finish(carg0 *C.GTlsInteraction, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.GTlsInteractionResult) {
+	defer core.RecoverCallback("_goglib_gio2_TlsInteraction_request_certificate_finish")
+
 	var fn func(carg0 *C.GTlsInteraction, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.GTlsInteractionResult)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsInteraction_request_certificate_finish").(func(carg0 *C.GTlsInteraction, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.GTlsInteractionResult))
@@ -1252,6 +1455,8 @@ func _goglib_gio2_TlsInteraction_request_certificate_finish(carg0 *C.GTlsInterac
 
 //export _goglib_gio2_TlsPassword_get_default_warning
 func _goglib_gio2_TlsPassword_get_default_warning(carg0 *C.GTlsPassword) (cret *C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_TlsPassword_get_default_warning")
+
 	var fn func(carg0 *C.GTlsPassword) (cret *C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsPassword_get_default_warning").(func(carg0 *C.GTlsPassword) (cret *C.gchar))
@@ -1264,6 +1469,8 @@ func _goglib_gio2_TlsPassword_get_default_warning(carg0 *C.GTlsPassword) (cret *
 
 //export _goglib_gio2_TlsPassword_get_value
 func _goglib_gio2_TlsPassword_get_value(carg0 *C.GTlsPassword, carg1 *C.gsize) (cret *C.guchar) {
+	defer core.RecoverCallback("_goglib_gio2_TlsPassword_get_value")
+
 	var fn func(carg0 *C.GTlsPassword, carg1 *C.gsize) (cret *C.guchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_TlsPassword_get_value").(func(carg0 *C.GTlsPassword, carg1 *C.gsize) (cret *C.guchar))
@@ -1276,6 +1483,8 @@ func _goglib_gio2_TlsPassword_get_value(carg0 *C.GTlsPassword, carg1 *C.gsize) (
 
 //export _goglib_gio2_Vfs_add_writable_namespaces
 func _goglib_gio2_Vfs_add_writable_namespaces(carg0 *C.GVfs, carg1 *C.GFileAttributeInfoList) {
+	defer core.RecoverCallback("_goglib_gio2_Vfs_add_writable_namespaces")
+
 	var fn func(carg0 *C.GVfs, carg1 *C.GFileAttributeInfoList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Vfs_add_writable_namespaces").(func(carg0 *C.GVfs, carg1 *C.GFileAttributeInfoList))
@@ -1288,6 +1497,8 @@ func _goglib_gio2_Vfs_add_writable_namespaces(carg0 *C.GVfs, carg1 *C.GFileAttri
 
 //export _goglib_gio2_Vfs_get_file_for_path
 func _goglib_gio2_Vfs_get_file_for_path(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile) {
+	defer core.RecoverCallback("_goglib_gio2_Vfs_get_file_for_path")
+
 	var fn func(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Vfs_get_file_for_path").(func(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile))
@@ -1300,6 +1511,8 @@ func _goglib_gio2_Vfs_get_file_for_path(carg0 *C.GVfs, carg1 *C.char) (cret *C.G
 
 //export _goglib_gio2_Vfs_get_file_for_uri
 func _goglib_gio2_Vfs_get_file_for_uri(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile) {
+	defer core.RecoverCallback("_goglib_gio2_Vfs_get_file_for_uri")
+
 	var fn func(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Vfs_get_file_for_uri").(func(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile))
@@ -1312,6 +1525,8 @@ func _goglib_gio2_Vfs_get_file_for_uri(carg0 *C.GVfs, carg1 *C.char) (cret *C.GF
 
 //export _goglib_gio2_Vfs_get_supported_uri_schemes
 func _goglib_gio2_Vfs_get_supported_uri_schemes(carg0 *C.GVfs) (cret **C.gchar) {
+	defer core.RecoverCallback("_goglib_gio2_Vfs_get_supported_uri_schemes")
+
 	var fn func(carg0 *C.GVfs) (cret **C.gchar)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Vfs_get_supported_uri_schemes").(func(carg0 *C.GVfs) (cret **C.gchar))
@@ -1324,6 +1539,8 @@ func _goglib_gio2_Vfs_get_supported_uri_schemes(carg0 *C.GVfs) (cret **C.gchar)
 
 //export _goglib_gio2_Vfs_is_active
 func _goglib_gio2_Vfs_is_active(carg0 *C.GVfs) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_Vfs_is_active")
+
 	var fn func(carg0 *C.GVfs) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Vfs_is_active").(func(carg0 *C.GVfs) (cret C.gboolean))
@@ -1336,6 +1553,8 @@ func _goglib_gio2_Vfs_is_active(carg0 *C.GVfs) (cret C.gboolean) {
 
 //export _goglib_gio2_Vfs_local_file_moved
 func _goglib_gio2_Vfs_local_file_moved(carg0 *C.GVfs, carg1 *C.char, carg2 *C.char) {
+	defer core.RecoverCallback("_goglib_gio2_Vfs_local_file_moved")
+
 	var fn func(carg0 *C.GVfs, carg1 *C.char, carg2 *C.char)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Vfs_local_file_moved").(func(carg0 *C.GVfs, carg1 *C.char, carg2 *C.char))
@@ -1348,6 +1567,8 @@ func _goglib_gio2_Vfs_local_file_moved(carg0 *C.GVfs, carg1 *C.char, carg2 *C.ch
 
 //export _goglib_gio2_Vfs_local_file_removed
 func _goglib_gio2_Vfs_local_file_removed(carg0 *C.GVfs, carg1 *C.char) {
+	defer core.RecoverCallback("_goglib_gio2_Vfs_local_file_removed")
+
 	var fn func(carg0 *C.GVfs, carg1 *C.char)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Vfs_local_file_removed").(func(carg0 *C.GVfs, carg1 *C.char))
@@ -1360,6 +1581,8 @@ func _goglib_gio2_Vfs_local_file_removed(carg0 *C.GVfs, carg1 *C.char) {
 
 //export _goglib_gio2_Vfs_local_file_set_attributes
 func _goglib_gio2_Vfs_local_file_set_attributes(carg0 *C.GVfs, carg1 *C.char, carg2 *C.GFileInfo, carg3 C.GFileQueryInfoFlags, carg4 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_Vfs_local_file_set_attributes")
+
 	var fn func(carg0 *C.GVfs, carg1 *C.char, carg2 *C.GFileInfo, carg3 C.GFileQueryInfoFlags, carg4 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Vfs_local_file_set_attributes").(func(carg0 *C.GVfs, carg1 *C.char, carg2 *C.GFileInfo, carg3 C.GFileQueryInfoFlags, carg4 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -1372,6 +1595,8 @@ func _goglib_gio2_Vfs_local_file_set_attributes(carg0 *C.GVfs, carg1 *C.char, ca
 
 //export _goglib_gio2_Vfs_parse_name
 func _goglib_gio2_Vfs_parse_name(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile) {
+	defer core.RecoverCallback("_goglib_gio2_Vfs_parse_name")
+
 	var fn func(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_Vfs_parse_name").(func(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile))
@@ -1384,6 +1609,8 @@ func _goglib_gio2_Vfs_parse_name(carg0 *C.GVfs, carg1 *C.char) (cret *C.GFile) {
 
 //export _goglib_gio2_VolumeMonitor_drive_changed
 func _goglib_gio2_VolumeMonitor_drive_changed(carg0 *C.GVolumeMonitor, carg1 *C.GDrive) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_drive_changed")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_drive_changed").(func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive))
@@ -1396,6 +1623,8 @@ func _goglib_gio2_VolumeMonitor_drive_changed(carg0 *C.GVolumeMonitor, carg1 *C.
 
 //export _goglib_gio2_VolumeMonitor_drive_connected
 func _goglib_gio2_VolumeMonitor_drive_connected(carg0 *C.GVolumeMonitor, carg1 *C.GDrive) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_drive_connected")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_drive_connected").(func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive))
@@ -1408,6 +1637,8 @@ func _goglib_gio2_VolumeMonitor_drive_connected(carg0 *C.GVolumeMonitor, carg1 *
 
 //export _goglib_gio2_VolumeMonitor_drive_disconnected
 func _goglib_gio2_VolumeMonitor_drive_disconnected(carg0 *C.GVolumeMonitor, carg1 *C.GDrive) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_drive_disconnected")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_drive_disconnected").(func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive))
@@ -1420,6 +1651,8 @@ func _goglib_gio2_VolumeMonitor_drive_disconnected(carg0 *C.GVolumeMonitor, carg
 
 //export _goglib_gio2_VolumeMonitor_drive_eject_button
 func _goglib_gio2_VolumeMonitor_drive_eject_button(carg0 *C.GVolumeMonitor, carg1 *C.GDrive) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_drive_eject_button")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_drive_eject_button").(func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive))
@@ -1432,6 +1665,8 @@ func _goglib_gio2_VolumeMonitor_drive_eject_button(carg0 *C.GVolumeMonitor, carg
 
 //export _goglib_gio2_VolumeMonitor_drive_stop_button
 func _goglib_gio2_VolumeMonitor_drive_stop_button(carg0 *C.GVolumeMonitor, carg1 *C.GDrive) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_drive_stop_button")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_drive_stop_button").(func(carg0 *C.GVolumeMonitor, carg1 *C.GDrive))
@@ -1444,6 +1679,8 @@ func _goglib_gio2_VolumeMonitor_drive_stop_button(carg0 *C.GVolumeMonitor, carg1
 
 //export _goglib_gio2_VolumeMonitor_get_connected_drives
 func _goglib_gio2_VolumeMonitor_get_connected_drives(carg0 *C.GVolumeMonitor) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_get_connected_drives")
+
 	var fn func(carg0 *C.GVolumeMonitor) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_get_connected_drives").(func(carg0 *C.GVolumeMonitor) (cret *C.GList))
@@ -1456,6 +1693,8 @@ func _goglib_gio2_VolumeMonitor_get_connected_drives(carg0 *C.GVolumeMonitor) (c
 
 //export _goglib_gio2_VolumeMonitor_get_mount_for_uuid
 func _goglib_gio2_VolumeMonitor_get_mount_for_uuid(carg0 *C.GVolumeMonitor, carg1 *C.char) (cret *C.GMount) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_get_mount_for_uuid")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.char) (cret *C.GMount)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_get_mount_for_uuid").(func(carg0 *C.GVolumeMonitor, carg1 *C.char) (cret *C.GMount))
@@ -1468,6 +1707,8 @@ func _goglib_gio2_VolumeMonitor_get_mount_for_uuid(carg0 *C.GVolumeMonitor, carg
 
 //export _goglib_gio2_VolumeMonitor_get_mounts
 func _goglib_gio2_VolumeMonitor_get_mounts(carg0 *C.GVolumeMonitor) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_get_mounts")
+
 	var fn func(carg0 *C.GVolumeMonitor) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_get_mounts").(func(carg0 *C.GVolumeMonitor) (cret *C.GList))
@@ -1480,6 +1721,8 @@ func _goglib_gio2_VolumeMonitor_get_mounts(carg0 *C.GVolumeMonitor) (cret *C.GLi
 
 //export _goglib_gio2_VolumeMonitor_get_volume_for_uuid
 func _goglib_gio2_VolumeMonitor_get_volume_for_uuid(carg0 *C.GVolumeMonitor, carg1 *C.char) (cret *C.GVolume) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_get_volume_for_uuid")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.char) (cret *C.GVolume)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_get_volume_for_uuid").(func(carg0 *C.GVolumeMonitor, carg1 *C.char) (cret *C.GVolume))
@@ -1492,6 +1735,8 @@ func _goglib_gio2_VolumeMonitor_get_volume_for_uuid(carg0 *C.GVolumeMonitor, car
 
 //export _goglib_gio2_VolumeMonitor_get_volumes
 func _goglib_gio2_VolumeMonitor_get_volumes(carg0 *C.GVolumeMonitor) (cret *C.GList) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_get_volumes")
+
 	var fn func(carg0 *C.GVolumeMonitor) (cret *C.GList)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_get_volumes").(func(carg0 *C.GVolumeMonitor) (cret *C.GList))
@@ -1504,6 +1749,8 @@ func _goglib_gio2_VolumeMonitor_get_volumes(carg0 *C.GVolumeMonitor) (cret *C.GL
 
 //export _goglib_gio2_VolumeMonitor_mount_added
 func _goglib_gio2_VolumeMonitor_mount_added(carg0 *C.GVolumeMonitor, carg1 *C.GMount) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_mount_added")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GMount)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_mount_added").(func(carg0 *C.GVolumeMonitor, carg1 *C.GMount))
@@ -1516,6 +1763,8 @@ func _goglib_gio2_VolumeMonitor_mount_added(carg0 *C.GVolumeMonitor, carg1 *C.GM
 
 //export _goglib_gio2_VolumeMonitor_mount_changed
 func _goglib_gio2_VolumeMonitor_mount_changed(carg0 *C.GVolumeMonitor, carg1 *C.GMount) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_mount_changed")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GMount)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_mount_changed").(func(carg0 *C.GVolumeMonitor, carg1 *C.GMount))
@@ -1528,6 +1777,8 @@ func _goglib_gio2_VolumeMonitor_mount_changed(carg0 *C.GVolumeMonitor, carg1 *C.
 
 //export _goglib_gio2_VolumeMonitor_mount_pre_unmount
 func _goglib_gio2_VolumeMonitor_mount_pre_unmount(carg0 *C.GVolumeMonitor, carg1 *C.GMount) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_mount_pre_unmount")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GMount)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_mount_pre_unmount").(func(carg0 *C.GVolumeMonitor, carg1 *C.GMount))
@@ -1540,6 +1791,8 @@ func _goglib_gio2_VolumeMonitor_mount_pre_unmount(carg0 *C.GVolumeMonitor, carg1
 
 //export _goglib_gio2_VolumeMonitor_mount_removed
 func _goglib_gio2_VolumeMonitor_mount_removed(carg0 *C.GVolumeMonitor, carg1 *C.GMount) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_mount_removed")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GMount)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_mount_removed").(func(carg0 *C.GVolumeMonitor, carg1 *C.GMount))
@@ -1552,6 +1805,8 @@ func _goglib_gio2_VolumeMonitor_mount_removed(carg0 *C.GVolumeMonitor, carg1 *C.
 
 //export _goglib_gio2_VolumeMonitor_volume_added
 func _goglib_gio2_VolumeMonitor_volume_added(carg0 *C.GVolumeMonitor, carg1 *C.GVolume) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_volume_added")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GVolume)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_volume_added").(func(carg0 *C.GVolumeMonitor, carg1 *C.GVolume))
@@ -1564,6 +1819,8 @@ func _goglib_gio2_VolumeMonitor_volume_added(carg0 *C.GVolumeMonitor, carg1 *C.G
 
 //export _goglib_gio2_VolumeMonitor_volume_changed
 func _goglib_gio2_VolumeMonitor_volume_changed(carg0 *C.GVolumeMonitor, carg1 *C.GVolume) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_volume_changed")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GVolume)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_volume_changed").(func(carg0 *C.GVolumeMonitor, carg1 *C.GVolume))
@@ -1576,6 +1833,8 @@ func _goglib_gio2_VolumeMonitor_volume_changed(carg0 *C.GVolumeMonitor, carg1 *C
 
 //export _goglib_gio2_VolumeMonitor_volume_removed
 func _goglib_gio2_VolumeMonitor_volume_removed(carg0 *C.GVolumeMonitor, carg1 *C.GVolume) {
+	defer core.RecoverCallback("_goglib_gio2_VolumeMonitor_volume_removed")
+
 	var fn func(carg0 *C.GVolumeMonitor, carg1 *C.GVolume)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_VolumeMonitor_volume_removed").(func(carg0 *C.GVolumeMonitor, carg1 *C.GVolume))
@@ -1588,6 +1847,8 @@ func _goglib_gio2_VolumeMonitor_volume_removed(carg0 *C.GVolumeMonitor, carg1 *C
 
 //export _goglib_gio2_FileIOStream_can_seek
 func _goglib_gio2_FileIOStream_can_seek(carg0 *C.GFileIOStream) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileIOStream_can_seek")
+
 	var fn func(carg0 *C.GFileIOStream) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileIOStream_can_seek").(func(carg0 *C.GFileIOStream) (cret C.gboolean))
@@ -1600,6 +1861,8 @@ func _goglib_gio2_FileIOStream_can_seek(carg0 *C.GFileIOStream) (cret C.gboolean
 
 //export _goglib_gio2_FileIOStream_can_truncate
 func _goglib_gio2_FileIOStream_can_truncate(carg0 *C.GFileIOStream) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileIOStream_can_truncate")
+
 	var fn func(carg0 *C.GFileIOStream) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileIOStream_can_truncate").(func(carg0 *C.GFileIOStream) (cret C.gboolean))
@@ -1612,6 +1875,8 @@ func _goglib_gio2_FileIOStream_can_truncate(carg0 *C.GFileIOStream) (cret C.gboo
 
 //export _goglib_gio2_FileIOStream_get_etag
 func _goglib_gio2_FileIOStream_get_etag(carg0 *C.GFileIOStream) (cret *C.char) {
+	defer core.RecoverCallback("_goglib_gio2_FileIOStream_get_etag")
+
 	var fn func(carg0 *C.GFileIOStream) (cret *C.char)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileIOStream_get_etag").(func(carg0 *C.GFileIOStream) (cret *C.char))
@@ -1624,6 +1889,8 @@ func _goglib_gio2_FileIOStream_get_etag(carg0 *C.GFileIOStream) (cret *C.char) {
 
 //export _goglib_gio2_FileIOStream_query_info
 func _goglib_gio2_FileIOStream_query_info(carg0 *C.GFileIOStream, carg1 *C.char, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo) {
+	defer core.RecoverCallback("_goglib_gio2_FileIOStream_query_info")
+
 	var fn func(carg0 *C.GFileIOStream, carg1 *C.char, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileIOStream_query_info").(func(carg0 *C.GFileIOStream, carg1 *C.char, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo))
@@ -1636,6 +1903,8 @@ func _goglib_gio2_FileIOStream_query_info(carg0 *C.GFileIOStream, carg1 *C.char,
 
 //export _goglib_gio2_FileIOStream_query_info_finish
 func _goglib_gio2_FileIOStream_query_info_finish(carg0 *C.GFileIOStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GFileInfo) {
+	defer core.RecoverCallback("_goglib_gio2_FileIOStream_query_info_finish")
+
 	var fn func(carg0 *C.GFileIOStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GFileInfo)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileIOStream_query_info_finish").(func(carg0 *C.GFileIOStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GFileInfo))
@@ -1648,6 +1917,8 @@ func _goglib_gio2_FileIOStream_query_info_finish(carg0 *C.GFileIOStream, carg1 *
 
 //export _goglib_gio2_FileIOStream_seek
 func _goglib_gio2_FileIOStream_seek(carg0 *C.GFileIOStream, carg1 C.goffset, carg2 C.GSeekType, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileIOStream_seek")
+
 	var fn func(carg0 *C.GFileIOStream, carg1 C.goffset, carg2 C.GSeekType, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileIOStream_seek").(func(carg0 *C.GFileIOStream, carg1 C.goffset, carg2 C.GSeekType, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -1660,6 +1931,8 @@ func _goglib_gio2_FileIOStream_seek(carg0 *C.GFileIOStream, carg1 C.goffset, car
 
 //export _goglib_gio2_FileIOStream_tell
 func _goglib_gio2_FileIOStream_tell(carg0 *C.GFileIOStream) (cret C.goffset) {
+	defer core.RecoverCallback("_goglib_gio2_FileIOStream_tell")
+
 	var fn func(carg0 *C.GFileIOStream) (cret C.goffset)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileIOStream_tell").(func(carg0 *C.GFileIOStream) (cret C.goffset))
@@ -1672,6 +1945,8 @@ func _goglib_gio2_FileIOStream_tell(carg0 *C.GFileIOStream) (cret C.goffset) {
 
 //export _goglib_gio2_FileIOStream_truncate_fn
 func _goglib_gio2_FileIOStream_truncate_fn(carg0 *C.GFileIOStream, carg1 C.goffset, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileIOStream_truncate_fn")
+
 	var fn func(carg0 *C.GFileIOStream, carg1 C.goffset, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileIOStream_truncate_fn").(func(carg0 *C.GFileIOStream, carg1 C.goffset, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -1684,6 +1959,8 @@ func _goglib_gio2_FileIOStream_truncate_fn(carg0 *C.GFileIOStream, carg1 C.goffs
 
 //export _goglib_gio2_FileInputStream_can_seek
 func _goglib_gio2_FileInputStream_can_seek(carg0 *C.GFileInputStream) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileInputStream_can_seek")
+
 	var fn func(carg0 *C.GFileInputStream) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileInputStream_can_seek").(func(carg0 *C.GFileInputStream) (cret C.gboolean))
@@ -1696,6 +1973,8 @@ func _goglib_gio2_FileInputStream_can_seek(carg0 *C.GFileInputStream) (cret C.gb
 
 //export _goglib_gio2_FileInputStream_query_info
 func _goglib_gio2_FileInputStream_query_info(carg0 *C.GFileInputStream, carg1 *C.char, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo) {
+	defer core.RecoverCallback("_goglib_gio2_FileInputStream_query_info")
+
 	var fn func(carg0 *C.GFileInputStream, carg1 *C.char, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileInputStream_query_info").(func(carg0 *C.GFileInputStream, carg1 *C.char, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo))
@@ -1708,6 +1987,8 @@ func _goglib_gio2_FileInputStream_query_info(carg0 *C.GFileInputStream, carg1 *C
 
 //export _goglib_gio2_FileInputStream_query_info_finish
 func _goglib_gio2_FileInputStream_query_info_finish(carg0 *C.GFileInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GFileInfo) {
+	defer core.RecoverCallback("_goglib_gio2_FileInputStream_query_info_finish")
+
 	var fn func(carg0 *C.GFileInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GFileInfo)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileInputStream_query_info_finish").(func(carg0 *C.GFileInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GFileInfo))
@@ -1720,6 +2001,8 @@ func _goglib_gio2_FileInputStream_query_info_finish(carg0 *C.GFileInputStream, c
 
 //export _goglib_gio2_FileInputStream_seek
 func _goglib_gio2_FileInputStream_seek(carg0 *C.GFileInputStream, carg1 C.goffset, carg2 C.GSeekType, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileInputStream_seek")
+
 	var fn func(carg0 *C.GFileInputStream, carg1 C.goffset, carg2 C.GSeekType, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileInputStream_seek").(func(carg0 *C.GFileInputStream, carg1 C.goffset, carg2 C.GSeekType, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -1732,6 +2015,8 @@ func _goglib_gio2_FileInputStream_seek(carg0 *C.GFileInputStream, carg1 C.goffse
 
 //export _goglib_gio2_FileInputStream_tell
 func _goglib_gio2_FileInputStream_tell(carg0 *C.GFileInputStream) (cret C.goffset) {
+	defer core.RecoverCallback("_goglib_gio2_FileInputStream_tell")
+
 	var fn func(carg0 *C.GFileInputStream) (cret C.goffset)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileInputStream_tell").(func(carg0 *C.GFileInputStream) (cret C.goffset))
@@ -1744,6 +2029,8 @@ func _goglib_gio2_FileInputStream_tell(carg0 *C.GFileInputStream) (cret C.goffse
 
 //export _goglib_gio2_FileOutputStream_can_seek
 func _goglib_gio2_FileOutputStream_can_seek(carg0 *C.GFileOutputStream) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileOutputStream_can_seek")
+
 	var fn func(carg0 *C.GFileOutputStream) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileOutputStream_can_seek").(func(carg0 *C.GFileOutputStream) (cret C.gboolean))
@@ -1756,6 +2043,8 @@ func _goglib_gio2_FileOutputStream_can_seek(carg0 *C.GFileOutputStream) (cret C.
 
 //export _goglib_gio2_FileOutputStream_can_truncate
 func _goglib_gio2_FileOutputStream_can_truncate(carg0 *C.GFileOutputStream) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileOutputStream_can_truncate")
+
 	var fn func(carg0 *C.GFileOutputStream) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileOutputStream_can_truncate").(func(carg0 *C.GFileOutputStream) (cret C.gboolean))
@@ -1768,6 +2057,8 @@ func _goglib_gio2_FileOutputStream_can_truncate(carg0 *C.GFileOutputStream) (cre
 
 //export _goglib_gio2_FileOutputStream_get_etag
 func _goglib_gio2_FileOutputStream_get_etag(carg0 *C.GFileOutputStream) (cret *C.char) {
+	defer core.RecoverCallback("_goglib_gio2_FileOutputStream_get_etag")
+
 	var fn func(carg0 *C.GFileOutputStream) (cret *C.char)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileOutputStream_get_etag").(func(carg0 *C.GFileOutputStream) (cret *C.char))
@@ -1780,6 +2071,8 @@ func _goglib_gio2_FileOutputStream_get_etag(carg0 *C.GFileOutputStream) (cret *C
 
 //export _goglib_gio2_FileOutputStream_query_info
 func _goglib_gio2_FileOutputStream_query_info(carg0 *C.GFileOutputStream, carg1 *C.char, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo) {
+	defer core.RecoverCallback("_goglib_gio2_FileOutputStream_query_info")
+
 	var fn func(carg0 *C.GFileOutputStream, carg1 *C.char, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileOutputStream_query_info").(func(carg0 *C.GFileOutputStream, carg1 *C.char, carg2 *C.GCancellable, _cerr **C.GError) (cret *C.GFileInfo))
@@ -1792,6 +2085,8 @@ func _goglib_gio2_FileOutputStream_query_info(carg0 *C.GFileOutputStream, carg1
 
 //export _goglib_gio2_FileOutputStream_query_info_finish
 func _goglib_gio2_FileOutputStream_query_info_finish(carg0 *C.GFileOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GFileInfo) {
+	defer core.RecoverCallback("_goglib_gio2_FileOutputStream_query_info_finish")
+
 	var fn func(carg0 *C.GFileOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GFileInfo)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileOutputStream_query_info_finish").(func(carg0 *C.GFileOutputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret *C.GFileInfo))
@@ -1804,6 +2099,8 @@ func _goglib_gio2_FileOutputStream_query_info_finish(carg0 *C.GFileOutputStream,
 
 //export _goglib_gio2_FileOutputStream_seek
 func _goglib_gio2_FileOutputStream_seek(carg0 *C.GFileOutputStream, carg1 C.goffset, carg2 C.GSeekType, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileOutputStream_seek")
+
 	var fn func(carg0 *C.GFileOutputStream, carg1 C.goffset, carg2 C.GSeekType, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileOutputStream_seek").(func(carg0 *C.GFileOutputStream, carg1 C.goffset, carg2 C.GSeekType, carg3 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -1816,6 +2113,8 @@ func _goglib_gio2_FileOutputStream_seek(carg0 *C.GFileOutputStream, carg1 C.goff
 
 //export _goglib_gio2_FileOutputStream_tell
 func _goglib_gio2_FileOutputStream_tell(carg0 *C.GFileOutputStream) (cret C.goffset) {
+	defer core.RecoverCallback("_goglib_gio2_FileOutputStream_tell")
+
 	var fn func(carg0 *C.GFileOutputStream) (cret C.goffset)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileOutputStream_tell").(func(carg0 *C.GFileOutputStream) (cret C.goffset))
@@ -1828,6 +2127,8 @@ func _goglib_gio2_FileOutputStream_tell(carg0 *C.GFileOutputStream) (cret C.goff
 
 //export _goglib_gio2_FileOutputStream_truncate_fn
 func _goglib_gio2_FileOutputStream_truncate_fn(carg0 *C.GFileOutputStream, carg1 C.goffset, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_gio2_FileOutputStream_truncate_fn")
+
 	var fn func(carg0 *C.GFileOutputStream, carg1 C.goffset, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gboolean)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_FileOutputStream_truncate_fn").(func(carg0 *C.GFileOutputStream, carg1 C.goffset, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gboolean))
@@ -1840,6 +2141,8 @@ func _goglib_gio2_FileOutputStream_truncate_fn(carg0 *C.GFileOutputStream, carg1
 
 //export _goglib_gio2_BufferedInputStream_fill
 func _goglib_gio2_BufferedInputStream_fill(carg0 *C.GBufferedInputStream, carg1 C.gssize, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_BufferedInputStream_fill")
+
 	var fn func(carg0 *C.GBufferedInputStream, carg1 C.gssize, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_BufferedInputStream_fill").(func(carg0 *C.GBufferedInputStream, carg1 C.gssize, carg2 *C.GCancellable, _cerr **C.GError) (cret C.gssize))
@@ -1852,6 +2155,8 @@ func _goglib_gio2_BufferedInputStream_fill(carg0 *C.GBufferedInputStream, carg1
 
 //export _goglib_gio2_BufferedInputStream_fill_finish
 func _goglib_gio2_BufferedInputStream_fill_finish(carg0 *C.GBufferedInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize) {
+	defer core.RecoverCallback("_goglib_gio2_BufferedInputStream_fill_finish")
+
 	var fn func(carg0 *C.GBufferedInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gio2_BufferedInputStream_fill_finish").(func(carg0 *C.GBufferedInputStream, carg1 *C.GAsyncResult, _cerr **C.GError) (cret C.gssize))
@@ -0,0 +1,139 @@
+package glib
+
+import "sync"
+
+// channelSourceMaxPending limits the amount of values that are received from the channel
+// but not yet dispatched, so that a slow main context applies backpressure to the sender.
+const channelSourceMaxPending = 128
+
+// channelSource holds the values that were received from the channel until the main context dispatches them.
+type channelSource[T any] struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	pending []T
+	closed  bool
+
+	// done is closed when the source is finalized to stop the receiving goroutine
+	done chan struct{}
+}
+
+// ChannelSource creates a [Source] that calls fn on the thread dispatching mainContext for every value
+// received from ch. A nil mainContext refers to the global default main context. The source is attached
+// to mainContext and removes itself after ch was closed and all values were dispatched.
+//
+// The values are received by a goroutine that wakes up mainContext when new values arrive, the values
+// that are pending at that point are dispatched in a single iteration of mainContext. The goroutine
+// ends when ch is closed or the source was destroyed and freed.
+func ChannelSource[T any](mainContext *MainContext, ch <-chan T, fn func(T)) *Source {
+	if mainContext == nil {
+		mainContext = MainContextDefault()
+	}
+
+	cs := &channelSource[T]{
+		done: make(chan struct{}),
+	}
+	cs.cond = sync.NewCond(&cs.mu)
+
+	source := NewCustomSource(SourceOverrides{
+		Prepare: func(*Source) (bool, int) {
+			return cs.ready(), -1
+		},
+		Check: func(*Source) bool {
+			return cs.ready()
+		},
+		Dispatch: func(*Source, SourceFunc) bool {
+			return cs.dispatch(fn)
+		},
+		Finalize: func(*Source) {
+			// the lock makes sure a receiver either sees done or already waits for the broadcast
+			cs.mu.Lock()
+			defer cs.mu.Unlock()
+
+			close(cs.done)
+
+			// wake up a receiver that waits for the pending values to be dispatched
+			cs.cond.Broadcast()
+		},
+	})
+
+	source.SetName("glib.ChannelSource")
+	source.Attach(mainContext)
+
+	go cs.receive(mainContext, ch)
+
+	return source
+}
+
+// receive forwards the values of ch to the source and wakes up the main context.
+func (cs *channelSource[T]) receive(mainContext *MainContext, ch <-chan T) {
+	for {
+		var v T
+		var ok bool
+
+		select {
+		case v, ok = <-ch:
+		case <-cs.done:
+			return
+		}
+
+		cs.mu.Lock()
+
+		if !ok {
+			cs.closed = true
+		} else {
+			for len(cs.pending) >= channelSourceMaxPending && !cs.isDone() {
+				cs.cond.Wait()
+			}
+
+			cs.pending = append(cs.pending, v)
+		}
+
+		wakeup := len(cs.pending) == 1 || !ok
+
+		cs.mu.Unlock()
+
+		if wakeup {
+			mainContext.Wakeup()
+		}
+
+		if !ok {
+			return
+		}
+	}
+}
+
+// isDone returns true if the source was finalized.
+func (cs *channelSource[T]) isDone() bool {
+	select {
+	case <-cs.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// ready returns true if the source has something to dispatch.
+func (cs *channelSource[T]) ready() bool {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return len(cs.pending) > 0 || cs.closed
+}
+
+// dispatch calls fn for all pending values and returns false once the channel was closed.
+func (cs *channelSource[T]) dispatch(fn func(T)) bool {
+	cs.mu.Lock()
+	pending := cs.pending
+	closed := cs.closed
+	cs.pending = nil
+	cs.mu.Unlock()
+
+	cs.cond.Broadcast()
+
+	for _, v := range pending {
+		fn(v)
+	}
+
+	return !closed
+}
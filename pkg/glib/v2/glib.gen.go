@@ -7587,6 +7587,21 @@ func IdleAddFull(priority int32, function SourceFunc) uint {
 	return goret
 }
 
+// NewIdleSource wraps g_idle_source_new
+// 
+// see also https://docs.gtk.org/glib/func.g_idle_source_new.html
+func NewIdleSource() *Source {
+	var cret *C.GSource // return, full, converted
+
+	cret = C.g_idle_source_new()
+
+	var goret *Source
+
+	goret = UnsafeSourceFromGlibFull(unsafe.Pointer(cret))
+
+	return goret
+}
+
 // InternStaticString wraps g_intern_static_string
 // 
 // see also https://docs.gtk.org/glib/func.g_intern_static_string.html
@@ -7631,6 +7646,28 @@ func InternString(str string) string {
 	return goret
 }
 
+// IOCreateWatch wraps g_io_create_watch
+// 
+// see also https://docs.gtk.org/glib/func.g_io_create_watch.html
+func IOCreateWatch(channel *IOChannel, condition IOCondition) *Source {
+	var carg1 *C.GIOChannel  // in, none, converted
+	var carg2 C.GIOCondition // in, none, casted
+	var cret  *C.GSource     // return, full, converted
+
+	carg1 = (*C.GIOChannel)(UnsafeIOChannelToGlibNone(channel))
+	carg2 = C.GIOCondition(condition)
+
+	cret = C.g_io_create_watch(carg1, carg2)
+	runtime.KeepAlive(channel)
+	runtime.KeepAlive(condition)
+
+	var goret *Source
+
+	goret = UnsafeSourceFromGlibFull(unsafe.Pointer(cret))
+
+	return goret
+}
+
 // Listenv wraps g_listenv
 // 
 // see also https://docs.gtk.org/glib/func.g_listenv.html
@@ -7870,6 +7907,27 @@ func LogStructuredArray(logLevel LogLevelFlags, fields []LogField) {
 	runtime.KeepAlive(fields)
 }
 
+// LogVariant wraps g_log_variant
+// 
+// see also https://docs.gtk.org/glib/func.g_log_variant.html
+func LogVariant(logDomain string, logLevel LogLevelFlags, fields *Variant) {
+	var carg1 *C.gchar         // in, none, string, nullable-string
+	var carg2 C.GLogLevelFlags // in, none, casted
+	var carg3 *C.GVariant      // in, none, converted
+
+	if logDomain != "" {
+		carg1 = (*C.gchar)(unsafe.Pointer(C.CString(logDomain)))
+		defer C.free(unsafe.Pointer(carg1))
+	}
+	carg2 = C.GLogLevelFlags(logLevel)
+	carg3 = (*C.GVariant)(UnsafeVariantToGlibNone(fields))
+
+	C.g_log_variant(carg1, carg2, carg3)
+	runtime.KeepAlive(logDomain)
+	runtime.KeepAlive(logLevel)
+	runtime.KeepAlive(fields)
+}
+
 // LogWriterDefaultSetUseStderr wraps g_log_writer_default_set_use_stderr
 // 
 // see also https://docs.gtk.org/glib/func.g_log_writer_default_set_use_stderr.html
@@ -7985,6 +8043,23 @@ func LogWriterSupportsColor(outputFd int32) bool {
 	return goret
 }
 
+// MainCurrentSource wraps g_main_current_source
+// 
+// see also https://docs.gtk.org/glib/func.g_main_current_source.html
+func MainCurrentSource() *Source {
+	var cret *C.GSource // return, none, converted, nullable
+
+	cret = C.g_main_current_source()
+
+	var goret *Source
+
+	if cret != nil {
+		goret = UnsafeSourceFromGlibNone(unsafe.Pointer(cret))
+	}
+
+	return goret
+}
+
 // MainDepth wraps g_main_depth
 // 
 // see also https://docs.gtk.org/glib/func.g_main_depth.html
@@ -10423,6 +10498,44 @@ func TimeoutAddSecondsFull(priority int32, interval uint, function SourceFunc) u
 	return goret
 }
 
+// NewTimeoutSource wraps g_timeout_source_new
+// 
+// see also https://docs.gtk.org/glib/func.g_timeout_source_new.html
+func NewTimeoutSource(interval uint) *Source {
+	var carg1 C.guint    // in, none, casted
+	var cret  *C.GSource // return, full, converted
+
+	carg1 = C.guint(interval)
+
+	cret = C.g_timeout_source_new(carg1)
+	runtime.KeepAlive(interval)
+
+	var goret *Source
+
+	goret = UnsafeSourceFromGlibFull(unsafe.Pointer(cret))
+
+	return goret
+}
+
+// TimeoutSourceNewSeconds wraps g_timeout_source_new_seconds
+// 
+// see also https://docs.gtk.org/glib/func.g_timeout_source_new_seconds.html
+func TimeoutSourceNewSeconds(interval uint) *Source {
+	var carg1 C.guint    // in, none, casted
+	var cret  *C.GSource // return, full, converted
+
+	carg1 = C.guint(interval)
+
+	cret = C.g_timeout_source_new_seconds(carg1)
+	runtime.KeepAlive(interval)
+
+	var goret *Source
+
+	goret = UnsafeSourceFromGlibFull(unsafe.Pointer(cret))
+
+	return goret
+}
+
 // UCS4ToUTF8 wraps g_ucs4_to_utf8
 // 
 // see also https://docs.gtk.org/glib/func.g_ucs4_to_utf8.html
@@ -17210,6 +17323,28 @@ func (_context *MainContext) Dispatch() {
 	runtime.KeepAlive(_context)
 }
 
+// FindSourceByID wraps g_main_context_find_source_by_id
+// 
+// see also https://docs.gtk.org/glib/method.g_main_context_find_source_by_id.g_main_context_find_source_by_id.html
+func (_context *MainContext) FindSourceByID(sourceId uint) *Source {
+	var carg0 *C.GMainContext // in, none, converted
+	var carg1 C.guint         // in, none, casted
+	var cret  *C.GSource      // return, none, converted
+
+	carg0 = (*C.GMainContext)(UnsafeMainContextToGlibNone(_context))
+	carg1 = C.guint(sourceId)
+
+	cret = C.g_main_context_find_source_by_id(carg0, carg1)
+	runtime.KeepAlive(_context)
+	runtime.KeepAlive(sourceId)
+
+	var goret *Source
+
+	goret = UnsafeSourceFromGlibNone(unsafe.Pointer(cret))
+
+	return goret
+}
+
 // InvokeFull wraps g_main_context_invoke_full
 // 
 // see also https://docs.gtk.org/glib/method.g_main_context_invoke_full.g_main_context_invoke_full.html
@@ -17560,18 +17695,6 @@ func (loop *MainLoop) Quit() {
 	runtime.KeepAlive(loop)
 }
 
-// Run wraps g_main_loop_run
-// 
-// see also https://docs.gtk.org/glib/method.g_main_loop_run.g_main_loop_run.html
-func (loop *MainLoop) Run() {
-	var carg0 *C.GMainLoop // in, none, converted
-
-	carg0 = (*C.GMainLoop)(UnsafeMainLoopToGlibNone(loop))
-
-	C.g_main_loop_run(carg0)
-	runtime.KeepAlive(loop)
-}
-
 // MappedFile wraps GMappedFile
 // 
 // see also https://docs.gtk.org/glib/struct.MappedFile.html
@@ -25246,6 +25369,21 @@ func NewVariantBuilder(typ *VariantType) *VariantBuilder {
 	return goret
 }
 
+// AddValue wraps g_variant_builder_add_value
+// 
+// see also https://docs.gtk.org/glib/method.g_variant_builder_add_value.g_variant_builder_add_value.html
+func (builder *VariantBuilder) AddValue(value *Variant) {
+	var carg0 *C.GVariantBuilder // in, none, converted
+	var carg1 *C.GVariant        // in, none, converted
+
+	carg0 = (*C.GVariantBuilder)(UnsafeVariantBuilderToGlibNone(builder))
+	carg1 = (*C.GVariant)(UnsafeVariantToGlibNone(value))
+
+	C.g_variant_builder_add_value(carg0, carg1)
+	runtime.KeepAlive(builder)
+	runtime.KeepAlive(value)
+}
+
 // Close wraps g_variant_builder_close
 // 
 // see also https://docs.gtk.org/glib/method.g_variant_builder_close.g_variant_builder_close.html
@@ -25258,6 +25396,25 @@ func (builder *VariantBuilder) Close() {
 	runtime.KeepAlive(builder)
 }
 
+// End wraps g_variant_builder_end
+// 
+// see also https://docs.gtk.org/glib/method.g_variant_builder_end.g_variant_builder_end.html
+func (builder *VariantBuilder) End() *Variant {
+	var carg0 *C.GVariantBuilder // in, none, converted
+	var cret  *C.GVariant        // return, none, converted
+
+	carg0 = (*C.GVariantBuilder)(UnsafeVariantBuilderToGlibNone(builder))
+
+	cret = C.g_variant_builder_end(carg0)
+	runtime.KeepAlive(builder)
+
+	var goret *Variant
+
+	goret = UnsafeVariantFromGlibNone(unsafe.Pointer(cret))
+
+	return goret
+}
+
 // Open wraps g_variant_builder_open
 // 
 // see also https://docs.gtk.org/glib/method.g_variant_builder_open.g_variant_builder_open.html
@@ -25368,6 +25525,27 @@ func UnsafeVariantDictToGlibFull(v *VariantDict) unsafe.Pointer {
 	return _p
 }
 
+// NewVariantDict wraps g_variant_dict_new
+// 
+// see also https://docs.gtk.org/glib/func.g_variant_dict_new.html
+func NewVariantDict(fromAsv *Variant) *VariantDict {
+	var carg1 *C.GVariant     // in, none, converted, nullable
+	var cret  *C.GVariantDict // return, full, converted
+
+	if fromAsv != nil {
+		carg1 = (*C.GVariant)(UnsafeVariantToGlibNone(fromAsv))
+	}
+
+	cret = C.g_variant_dict_new(carg1)
+	runtime.KeepAlive(fromAsv)
+
+	var goret *VariantDict
+
+	goret = UnsafeVariantDictFromGlibFull(unsafe.Pointer(cret))
+
+	return goret
+}
+
 // Clear wraps g_variant_dict_clear
 // 
 // see also https://docs.gtk.org/glib/method.g_variant_dict_clear.g_variant_dict_clear.html
@@ -25405,6 +25583,74 @@ func (dict *VariantDict) Contains(key string) bool {
 	return goret
 }
 
+// End wraps g_variant_dict_end
+// 
+// see also https://docs.gtk.org/glib/method.g_variant_dict_end.g_variant_dict_end.html
+func (dict *VariantDict) End() *Variant {
+	var carg0 *C.GVariantDict // in, none, converted
+	var cret  *C.GVariant     // return, none, converted
+
+	carg0 = (*C.GVariantDict)(UnsafeVariantDictToGlibNone(dict))
+
+	cret = C.g_variant_dict_end(carg0)
+	runtime.KeepAlive(dict)
+
+	var goret *Variant
+
+	goret = UnsafeVariantFromGlibNone(unsafe.Pointer(cret))
+
+	return goret
+}
+
+// InsertValue wraps g_variant_dict_insert_value
+// 
+// see also https://docs.gtk.org/glib/method.g_variant_dict_insert_value.g_variant_dict_insert_value.html
+func (dict *VariantDict) InsertValue(key string, value *Variant) {
+	var carg0 *C.GVariantDict // in, none, converted
+	var carg1 *C.gchar        // in, none, string
+	var carg2 *C.GVariant     // in, none, converted
+
+	carg0 = (*C.GVariantDict)(UnsafeVariantDictToGlibNone(dict))
+	carg1 = (*C.gchar)(unsafe.Pointer(C.CString(key)))
+	defer C.free(unsafe.Pointer(carg1))
+	carg2 = (*C.GVariant)(UnsafeVariantToGlibNone(value))
+
+	C.g_variant_dict_insert_value(carg0, carg1, carg2)
+	runtime.KeepAlive(dict)
+	runtime.KeepAlive(key)
+	runtime.KeepAlive(value)
+}
+
+// LookupValue wraps g_variant_dict_lookup_value
+// 
+// see also https://docs.gtk.org/glib/method.g_variant_dict_lookup_value.g_variant_dict_lookup_value.html
+func (dict *VariantDict) LookupValue(key string, expectedType *VariantType) *Variant {
+	var carg0 *C.GVariantDict // in, none, converted
+	var carg1 *C.gchar        // in, none, string
+	var carg2 *C.GVariantType // in, none, converted, nullable
+	var cret  *C.GVariant     // return, full, converted, nullable
+
+	carg0 = (*C.GVariantDict)(UnsafeVariantDictToGlibNone(dict))
+	carg1 = (*C.gchar)(unsafe.Pointer(C.CString(key)))
+	defer C.free(unsafe.Pointer(carg1))
+	if expectedType != nil {
+		carg2 = (*C.GVariantType)(UnsafeVariantTypeToGlibNone(expectedType))
+	}
+
+	cret = C.g_variant_dict_lookup_value(carg0, carg1, carg2)
+	runtime.KeepAlive(dict)
+	runtime.KeepAlive(key)
+	runtime.KeepAlive(expectedType)
+
+	var goret *Variant
+
+	if cret != nil {
+		goret = UnsafeVariantFromGlibFull(unsafe.Pointer(cret))
+	}
+
+	return goret
+}
+
 // Remove wraps g_variant_dict_remove
 // 
 // see also https://docs.gtk.org/glib/method.g_variant_dict_remove.g_variant_dict_remove.html
@@ -5,6 +5,7 @@ package glib
 import (
 	"unsafe"
 
+	"github.com/go-gst/go-glib/pkg/core"
 	"github.com/go-gst/go-glib/pkg/core/userdata"
 )
 
@@ -13,6 +14,8 @@ import "C"
 
 //export _goglib_glib2_LogFunc
 func _goglib_glib2_LogFunc(carg1 *C.gchar, carg2 C.GLogLevelFlags, carg3 *C.gchar, carg4 C.gpointer) {
+	defer core.RecoverCallback("_goglib_glib2_LogFunc")
+
 	var fn LogFunc
 	{
 		v := userdata.Load(unsafe.Pointer(carg4))
@@ -37,6 +40,8 @@ func _goglib_glib2_LogFunc(carg1 *C.gchar, carg2 C.GLogLevelFlags, carg3 *C.gcha
 
 //export _goglib_glib2_LogWriterFunc
 func _goglib_glib2_LogWriterFunc(carg1 C.GLogLevelFlags, carg2 *C.GLogField, carg3 C.gsize, carg4 C.gpointer) (cret C.GLogWriterOutput) {
+	defer core.RecoverCallback("_goglib_glib2_LogWriterFunc")
+
 	var fn LogWriterFunc
 	{
 		v := userdata.Load(unsafe.Pointer(carg4))
@@ -65,6 +70,8 @@ func _goglib_glib2_LogWriterFunc(carg1 C.GLogLevelFlags, carg2 *C.GLogField, car
 
 //export _goglib_glib2_SourceFunc
 func _goglib_glib2_SourceFunc(carg1 C.gpointer) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_glib2_SourceFunc")
+
 	var fn SourceFunc
 	{
 		v := userdata.Load(unsafe.Pointer(carg1))
@@ -87,6 +94,8 @@ func _goglib_glib2_SourceFunc(carg1 C.gpointer) (cret C.gboolean) {
 
 //export _goglib_glib2_SourceOnceFunc
 func _goglib_glib2_SourceOnceFunc(carg1 C.gpointer) {
+	defer core.RecoverCallback("_goglib_glib2_SourceOnceFunc")
+
 	var fn SourceOnceFunc
 	{
 		v := userdata.Load(unsafe.Pointer(carg1))
@@ -101,6 +110,8 @@ func _goglib_glib2_SourceOnceFunc(carg1 C.gpointer) {
 
 //export _goglib_glib2_TestDataFunc
 func _goglib_glib2_TestDataFunc(carg1 C.gconstpointer) {
+	defer core.RecoverCallback("_goglib_glib2_TestDataFunc")
+
 	var fn TestDataFunc
 	{
 		v := userdata.Load(unsafe.Pointer(carg1))
@@ -115,6 +126,8 @@ func _goglib_glib2_TestDataFunc(carg1 C.gconstpointer) {
 
 //export _goglib_glib2_TestLogFatalFunc
 func _goglib_glib2_TestLogFatalFunc(carg1 *C.gchar, carg2 C.GLogLevelFlags, carg3 *C.gchar, carg4 C.gpointer) (cret C.gboolean) {
+	defer core.RecoverCallback("_goglib_glib2_TestLogFatalFunc")
+
 	var fn TestLogFatalFunc
 	{
 		v := userdata.Load(unsafe.Pointer(carg4))
@@ -0,0 +1,175 @@
+package glib
+
+import (
+	"context"
+	"log"
+	"runtime"
+)
+
+// Invoke calls fn on the thread that owns mainContext. A nil mainContext refers to the global
+// default main context.
+//
+// If mainContext is owned by the calling thread, or not owned by any thread, fn is called right
+// away before Invoke returns. Otherwise fn is dispatched by the owning thread the next time it
+// iterates mainContext.
+//
+// see also https://docs.gtk.org/glib/method.MainContext.invoke_full.html
+func (mainContext *MainContext) Invoke(fn func()) {
+	if mainContext == nil {
+		mainContext = MainContextDefault()
+	}
+
+	mainContext.InvokeFull(PRIORITY_DEFAULT, func() bool {
+		fn()
+
+		return false
+	})
+}
+
+// InvokeSync calls fn on the thread that owns mainContext like [MainContext.Invoke] and blocks
+// until it returned. A panic in fn is propagated to the caller.
+//
+// This is a function instead of a method because go methods can't be generic.
+func InvokeSync[T any](mainContext *MainContext, fn func() T) T {
+	if mainContext == nil {
+		mainContext = MainContextDefault()
+	}
+
+	type result struct {
+		value    T
+		panicked bool
+		panicVal any
+	}
+
+	results := make(chan result, 1)
+
+	mainContext.Invoke(func() {
+		var r result
+
+		defer func() {
+			if r.panicked {
+				r.panicVal = recover()
+			}
+
+			results <- r
+		}()
+
+		r.panicked = true
+		r.value = fn()
+		r.panicked = false
+	})
+
+	r := <-results
+
+	if r.panicked {
+		panic(r.panicVal)
+	}
+
+	return r.value
+}
+
+// LockOSThread locks the calling goroutine to its OS thread and makes the thread the owner of
+// mainContext by acquiring it and pushing it as the thread default main context. This allows the
+// goroutine to iterate mainContext, run a [MainLoop] on it or start asynchronous operations that
+// complete on it. A nil mainContext refers to the global default main context.
+//
+// The returned func undoes everything and must be called from the same goroutine. LockOSThread
+// panics if mainContext is owned by another thread.
+func (mainContext *MainContext) LockOSThread() (unlock func()) {
+	if mainContext == nil {
+		mainContext = MainContextDefault()
+	}
+
+	runtime.LockOSThread()
+
+	if !mainContext.Acquire() {
+		runtime.UnlockOSThread()
+
+		log.Panicf("glib: main context is owned by another thread")
+	}
+
+	mainContext.PushThreadDefault()
+
+	return func() {
+		mainContext.PopThreadDefault()
+		mainContext.Release()
+
+		runtime.UnlockOSThread()
+	}
+}
+
+// AwaitAsync starts an asynchronous operation on the given MainContext and blocks until it completed or
+// ctx is done. It is used by the generated blocking wrappers of async/finish method pairs.
+//
+// start is called with mainContext pushed as the thread default main context, so the GAsyncReadyCallback
+// of the operation is dispatched on mainContext. The callback must call done exactly once with the
+// result of the operation. A nil mainContext refers to the global default main context.
+//
+// If no other thread owns mainContext, or the calling goroutine is running on the owning thread, the
+// calling goroutine iterates mainContext itself until the operation completed. Otherwise the operation
+// is started on the owning thread, which must keep iterating mainContext for the operation to complete.
+//
+// If ctx is done first, AwaitAsync returns ctx.Err() without waiting for the callback. start should
+// pass ctx to the operation, so that it is cancelled as well.
+func AwaitAsync[T any](ctx context.Context, mainContext *MainContext, start func(done func(T))) (T, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if mainContext == nil {
+		mainContext = MainContextDefault()
+	}
+
+	var zero T
+
+	// acquiring the context, pushing it and iterating it is bound to the OS thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if mainContext.Acquire() {
+		defer mainContext.Release()
+
+		var result T
+		finished := false
+
+		mainContext.PushThreadDefault()
+		defer mainContext.PopThreadDefault()
+
+		start(func(r T) {
+			result = r
+			finished = true
+		})
+
+		// interrupt the blocking iteration when ctx is done
+		stop := context.AfterFunc(ctx, mainContext.Wakeup)
+		defer stop()
+
+		for !finished {
+			if err := ctx.Err(); err != nil {
+				return zero, err
+			}
+
+			mainContext.Iteration(true)
+		}
+
+		return result, nil
+	}
+
+	results := make(chan T, 1)
+
+	mainContext.Invoke(func() {
+		mainContext.PushThreadDefault()
+		defer mainContext.PopThreadDefault()
+
+		start(func(r T) {
+			results <- r
+		})
+	})
+
+	select {
+	case r := <-results:
+		return r, nil
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,74 @@
+package glib
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// #include <glib.h>
+//
+// static gboolean _goglib_main_loop_quit(gpointer loop) {
+//	g_main_loop_quit((GMainLoop*)loop);
+//	return G_SOURCE_REMOVE;
+// }
+//
+// static GSource* _goglib_main_loop_quit_source(GMainLoop* loop) {
+//	GSource* source = g_idle_source_new();
+//	g_source_set_priority(source, G_PRIORITY_HIGH);
+//	g_source_set_callback(source, _goglib_main_loop_quit, g_main_loop_ref(loop), (GDestroyNotify)g_main_loop_unref);
+//	g_source_attach(source, g_main_loop_get_context(loop));
+//	return source;
+// }
+import "C"
+
+// Run runs the main loop until [MainLoop.Quit] is called or ctx is done. The calling goroutine
+// is locked to its OS thread while the loop is running. Run returns right away if ctx is already done.
+//
+// The quit request for ctx is dispatched by the main loop itself, so ctx being cancelled while
+// the loop is starting up is not lost.
+//
+// see also https://docs.gtk.org/glib/method.MainLoop.run.html
+func (loop *MainLoop) Run(ctx context.Context) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if ctx.Done() != nil {
+		var mu sync.Mutex
+		var quit *C.GSource
+		returned := false
+
+		stop := context.AfterFunc(ctx, func() {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if !returned {
+				quit = C._goglib_main_loop_quit_source((*C.GMainLoop)(UnsafeMainLoopToGlibNone(loop)))
+			}
+		})
+
+		defer func() {
+			if stop() {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			returned = true
+
+			// destroy the quit source in case the loop was quit by someone else before it was dispatched
+			if quit != nil {
+				C.g_source_destroy(quit)
+				C.g_source_unref(quit)
+			}
+		}()
+	}
+
+	C.g_main_loop_run((*C.GMainLoop)(UnsafeMainLoopToGlibNone(loop)))
+	runtime.KeepAlive(loop)
+}
@@ -0,0 +1,385 @@
+package glib
+
+// #cgo pkg-config: glib-2.0
+// #cgo CFLAGS: -Wno-deprecated-declarations
+// #include <stdlib.h>
+// #include <glib.h>
+// extern gboolean _goglib_glib2_SourceFunc(gpointer);
+// extern void destroyUserdata(gpointer);
+// extern gboolean _goglib_glib2_Source_prepare(GSource*, gint*);
+// extern gboolean _goglib_glib2_Source_check(GSource*);
+// extern gboolean _goglib_glib2_Source_dispatch(GSource*, GSourceFunc, gpointer);
+// extern void _goglib_glib2_Source_finalize(GSource*);
+//
+// typedef struct {
+//	GSource source;
+//	gpointer overrides;
+// } _goglib_GoSource;
+//
+// static GSourceFuncs _goglib_go_source_funcs = {
+//	_goglib_glib2_Source_prepare,
+//	_goglib_glib2_Source_check,
+//	_goglib_glib2_Source_dispatch,
+//	_goglib_glib2_Source_finalize,
+// };
+//
+// static GSource* _goglib_go_source_new(gpointer overrides) {
+//	GSource* source = g_source_new(&_goglib_go_source_funcs, sizeof(_goglib_GoSource));
+//	((_goglib_GoSource*)source)->overrides = overrides;
+//	return source;
+// }
+//
+// static gpointer _goglib_go_source_overrides(GSource* source) {
+//	return ((_goglib_GoSource*)source)->overrides;
+// }
+//
+// static gboolean _goglib_source_func_call(GSourceFunc fn, gpointer data) {
+//	return fn(data);
+// }
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/userdata"
+)
+
+// Source wraps GSource
+//
+// A Source is an event source that is dispatched by a [MainContext]. Custom sources can be implemented
+// in go with [NewCustomSource].
+//
+// see also https://docs.gtk.org/glib/struct.Source.html
+type Source struct {
+	*source
+}
+
+// source is the struct that's finalized
+type source struct {
+	native *C.GSource
+}
+
+// UnsafeSourceFromGlibBorrow is used to convert raw C.GSource pointers to go without touching any references. This is used by the bindings internally.
+func UnsafeSourceFromGlibBorrow(p unsafe.Pointer) *Source {
+	if p == nil {
+		return nil
+	}
+	return &Source{&source{(*C.GSource)(p)}}
+}
+
+// UnsafeSourceFromGlibNone is used to convert raw C.GSource pointers to go without transferring ownership. This is used by the bindings internally.
+func UnsafeSourceFromGlibNone(p unsafe.Pointer) *Source {
+	wrapped := UnsafeSourceFromGlibBorrow(p)
+	if wrapped == nil {
+		return nil
+	}
+
+	C.g_source_ref(wrapped.native)
+
+	runtime.SetFinalizer(
+		wrapped.source,
+		func(intern *source) {
+			C.g_source_unref(intern.native)
+		},
+	)
+	return wrapped
+}
+
+// UnsafeSourceFromGlibFull is used to convert raw C.GSource pointers to go while taking ownership. This is used by the bindings internally.
+func UnsafeSourceFromGlibFull(p unsafe.Pointer) *Source {
+	wrapped := UnsafeSourceFromGlibBorrow(p)
+	if wrapped == nil {
+		return nil
+	}
+
+	runtime.SetFinalizer(
+		wrapped.source,
+		func(intern *source) {
+			C.g_source_unref(intern.native)
+		},
+	)
+	return wrapped
+}
+
+// UnsafeSourceRef increases the refcount on the underlying resource.
+//
+// When this is called without an associated call to [UnsafeSourceUnref], then [Source] will leak memory.
+func UnsafeSourceRef(s *Source) {
+	C.g_source_ref(s.native)
+}
+
+// UnsafeSourceUnref unrefs/frees the underlying resource. This can be used to remove the instance before the GC decides to do so.
+//
+// After this is called, no other method on [Source] is expected to work anymore.
+func UnsafeSourceUnref(s *Source) {
+	C.g_source_unref(s.native)
+	runtime.SetFinalizer(s.source, nil)
+}
+
+// UnsafeSourceToGlibNone returns the underlying C pointer. This is used by the bindings internally.
+func UnsafeSourceToGlibNone(s *Source) unsafe.Pointer {
+	if s == nil {
+		return nil
+	}
+	return unsafe.Pointer(s.native)
+}
+
+// UnsafeSourceToGlibFull returns the underlying C pointer with an additional reference that is owned by the receiver.
+// This is used by the bindings internally.
+func UnsafeSourceToGlibFull(s *Source) unsafe.Pointer {
+	if s == nil {
+		return nil
+	}
+
+	C.g_source_ref(s.native)
+
+	return unsafe.Pointer(s.native)
+}
+
+// SourceOverrides contains the callbacks of a custom source created with [NewCustomSource]. All
+// callbacks are optional and are called on the thread that dispatches the [MainContext] the source
+// is attached to.
+//
+// The [Source] passed to the callbacks is borrowed and must not be used after the callback returned.
+//
+// see also https://docs.gtk.org/glib/struct.SourceFuncs.html
+type SourceOverrides struct {
+	// Prepare is called before the main context polls for new events. It returns whether the source
+	// is ready to be dispatched without polling and the maximum timeout in milliseconds for the poll,
+	// -1 for no timeout. The source is also dispatched when its ready time was reached.
+	Prepare func(source *Source) (ready bool, timeout int)
+
+	// Check is called after polling and returns whether the source is ready to be dispatched.
+	Check func(source *Source) bool
+
+	// Dispatch dispatches the events of the source. callback is the callback set with
+	// [Source.SetCallback], or nil if there is none. The source is removed when Dispatch
+	// returns false. If Dispatch is nil, then the callback is called.
+	Dispatch func(source *Source, callback SourceFunc) bool
+
+	// Finalize is called when the source is freed.
+	Finalize func(source *Source)
+}
+
+// NewCustomSource creates a new [Source] that is implemented by the given overrides. The source
+// is not attached to any [MainContext] yet.
+//
+// see also https://docs.gtk.org/glib/ctor.Source.new.html
+func NewCustomSource(overrides SourceOverrides) *Source {
+	csource := C._goglib_go_source_new(C.gpointer(userdata.Register(&overrides)))
+
+	return UnsafeSourceFromGlibFull(unsafe.Pointer(csource))
+}
+
+// sourceOverrides returns the overrides of a source created by NewCustomSource.
+func sourceOverrides(s *C.GSource) *SourceOverrides {
+	return userdata.Load(unsafe.Pointer(C._goglib_go_source_overrides(s))).(*SourceOverrides)
+}
+
+// releaseSourceOverrides frees the registration of the overrides when the source is finalized.
+func releaseSourceOverrides(s *C.GSource) {
+	userdata.Delete(unsafe.Pointer(C._goglib_go_source_overrides(s)))
+}
+
+// wrapSourceFunc turns the C callback of a source into a go SourceFunc.
+func wrapSourceFunc(fn C.GSourceFunc, data C.gpointer) SourceFunc {
+	if fn == nil {
+		return nil
+	}
+
+	return func() bool {
+		return C._goglib_source_func_call(fn, data) != 0
+	}
+}
+
+// Attach wraps g_source_attach
+//
+// A nil MainContext attaches the source to the global default main context.
+//
+// see also https://docs.gtk.org/glib/method.Source.attach.html
+func (s *Source) Attach(context *MainContext) uint {
+	id := C.g_source_attach(s.native, (*C.GMainContext)(UnsafeMainContextToGlibNone(context)))
+	runtime.KeepAlive(s)
+	runtime.KeepAlive(context)
+
+	return uint(id)
+}
+
+// Destroy wraps g_source_destroy
+//
+// see also https://docs.gtk.org/glib/method.Source.destroy.html
+func (s *Source) Destroy() {
+	C.g_source_destroy(s.native)
+	runtime.KeepAlive(s)
+}
+
+// IsDestroyed wraps g_source_is_destroyed
+//
+// see also https://docs.gtk.org/glib/method.Source.is_destroyed.html
+func (s *Source) IsDestroyed() bool {
+	destroyed := C.g_source_is_destroyed(s.native) != 0
+	runtime.KeepAlive(s)
+
+	return destroyed
+}
+
+// GetContext wraps g_source_get_context
+//
+// see also https://docs.gtk.org/glib/method.Source.get_context.html
+func (s *Source) GetContext() *MainContext {
+	context := C.g_source_get_context(s.native)
+	runtime.KeepAlive(s)
+
+	if context == nil {
+		return nil
+	}
+
+	return UnsafeMainContextFromGlibNone(unsafe.Pointer(context))
+}
+
+// GetID wraps g_source_get_id
+//
+// see also https://docs.gtk.org/glib/method.Source.get_id.html
+func (s *Source) GetID() uint {
+	id := C.g_source_get_id(s.native)
+	runtime.KeepAlive(s)
+
+	return uint(id)
+}
+
+// GetPriority wraps g_source_get_priority
+//
+// see also https://docs.gtk.org/glib/method.Source.get_priority.html
+func (s *Source) GetPriority() int32 {
+	priority := C.g_source_get_priority(s.native)
+	runtime.KeepAlive(s)
+
+	return int32(priority)
+}
+
+// SetPriority wraps g_source_set_priority
+//
+// see also https://docs.gtk.org/glib/method.Source.set_priority.html
+func (s *Source) SetPriority(priority int32) {
+	C.g_source_set_priority(s.native, C.gint(priority))
+	runtime.KeepAlive(s)
+}
+
+// GetReadyTime wraps g_source_get_ready_time
+//
+// see also https://docs.gtk.org/glib/method.Source.get_ready_time.html
+func (s *Source) GetReadyTime() int64 {
+	readyTime := C.g_source_get_ready_time(s.native)
+	runtime.KeepAlive(s)
+
+	return int64(readyTime)
+}
+
+// SetReadyTime wraps g_source_set_ready_time
+//
+// The ready time is in the monotonic time base of [GetMonotonicTime], -1 disables it and 0 makes the
+// source ready right away.
+//
+// see also https://docs.gtk.org/glib/method.Source.set_ready_time.html
+func (s *Source) SetReadyTime(readyTime int64) {
+	C.g_source_set_ready_time(s.native, C.gint64(readyTime))
+	runtime.KeepAlive(s)
+}
+
+// GetTime wraps g_source_get_time
+//
+// see also https://docs.gtk.org/glib/method.Source.get_time.html
+func (s *Source) GetTime() int64 {
+	t := C.g_source_get_time(s.native)
+	runtime.KeepAlive(s)
+
+	return int64(t)
+}
+
+// GetName wraps g_source_get_name
+//
+// see also https://docs.gtk.org/glib/method.Source.get_name.html
+func (s *Source) GetName() string {
+	name := C.g_source_get_name(s.native)
+	runtime.KeepAlive(s)
+
+	if name == nil {
+		return ""
+	}
+
+	return C.GoString(name)
+}
+
+// SetName wraps g_source_set_name
+//
+// see also https://docs.gtk.org/glib/method.Source.set_name.html
+func (s *Source) SetName(name string) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	C.g_source_set_name(s.native, cname)
+	runtime.KeepAlive(s)
+}
+
+// GetCanRecurse wraps g_source_get_can_recurse
+//
+// see also https://docs.gtk.org/glib/method.Source.get_can_recurse.html
+func (s *Source) GetCanRecurse() bool {
+	canRecurse := C.g_source_get_can_recurse(s.native) != 0
+	runtime.KeepAlive(s)
+
+	return canRecurse
+}
+
+// SetCanRecurse wraps g_source_set_can_recurse
+//
+// see also https://docs.gtk.org/glib/method.Source.set_can_recurse.html
+func (s *Source) SetCanRecurse(canRecurse bool) {
+	var ccanRecurse C.gboolean
+	if canRecurse {
+		ccanRecurse = C.TRUE
+	}
+
+	C.g_source_set_can_recurse(s.native, ccanRecurse)
+	runtime.KeepAlive(s)
+}
+
+// SetCallback wraps g_source_set_callback
+//
+// The callback is released when the source is destroyed or another callback is set.
+//
+// see also https://docs.gtk.org/glib/method.Source.set_callback.html
+func (s *Source) SetCallback(fn SourceFunc) {
+	if fn == nil {
+		C.g_source_set_callback(s.native, nil, nil, nil)
+		runtime.KeepAlive(s)
+		return
+	}
+
+	C.g_source_set_callback(
+		s.native,
+		(C.GSourceFunc)((*[0]byte)(C._goglib_glib2_SourceFunc)),
+		C.gpointer(userdata.Register(fn)),
+		(C.GDestroyNotify)((*[0]byte)(C.destroyUserdata)),
+	)
+	runtime.KeepAlive(s)
+}
+
+// AddChildSource wraps g_source_add_child_source
+//
+// see also https://docs.gtk.org/glib/method.Source.add_child_source.html
+func (s *Source) AddChildSource(child *Source) {
+	C.g_source_add_child_source(s.native, child.native)
+	runtime.KeepAlive(s)
+	runtime.KeepAlive(child)
+}
+
+// RemoveChildSource wraps g_source_remove_child_source
+//
+// see also https://docs.gtk.org/glib/method.Source.remove_child_source.html
+func (s *Source) RemoveChildSource(child *Source) {
+	C.g_source_remove_child_source(s.native, child.native)
+	runtime.KeepAlive(s)
+	runtime.KeepAlive(child)
+}
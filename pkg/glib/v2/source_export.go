@@ -0,0 +1,86 @@
+package glib
+
+// #include <glib.h>
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core"
+)
+
+//export _goglib_glib2_Source_prepare
+func _goglib_glib2_Source_prepare(csource *C.GSource, ctimeout *C.gint) C.gboolean {
+	defer core.RecoverCallback("_goglib_glib2_Source_prepare")
+
+	overrides := sourceOverrides(csource)
+
+	*ctimeout = -1
+
+	if overrides.Prepare == nil {
+		return C.FALSE
+	}
+
+	ready, timeout := overrides.Prepare(UnsafeSourceFromGlibBorrow(unsafe.Pointer(csource)))
+
+	*ctimeout = C.gint(timeout)
+
+	if ready {
+		return C.TRUE
+	}
+
+	return C.FALSE
+}
+
+//export _goglib_glib2_Source_check
+func _goglib_glib2_Source_check(csource *C.GSource) C.gboolean {
+	defer core.RecoverCallback("_goglib_glib2_Source_check")
+
+	overrides := sourceOverrides(csource)
+
+	if overrides.Check == nil {
+		return C.FALSE
+	}
+
+	if overrides.Check(UnsafeSourceFromGlibBorrow(unsafe.Pointer(csource))) {
+		return C.TRUE
+	}
+
+	return C.FALSE
+}
+
+//export _goglib_glib2_Source_dispatch
+func _goglib_glib2_Source_dispatch(csource *C.GSource, ccallback C.GSourceFunc, cdata C.gpointer) C.gboolean {
+	defer core.RecoverCallback("_goglib_glib2_Source_dispatch")
+
+	overrides := sourceOverrides(csource)
+
+	callback := wrapSourceFunc(ccallback, cdata)
+
+	var keep bool
+
+	switch {
+	case overrides.Dispatch != nil:
+		keep = overrides.Dispatch(UnsafeSourceFromGlibBorrow(unsafe.Pointer(csource)), callback)
+	case callback != nil:
+		keep = callback()
+	}
+
+	if keep {
+		return C.TRUE
+	}
+
+	return C.FALSE
+}
+
+//export _goglib_glib2_Source_finalize
+func _goglib_glib2_Source_finalize(csource *C.GSource) {
+	defer core.RecoverCallback("_goglib_glib2_Source_finalize")
+
+	overrides := sourceOverrides(csource)
+	defer releaseSourceOverrides(csource)
+
+	if overrides.Finalize != nil {
+		overrides.Finalize(UnsafeSourceFromGlibBorrow(unsafe.Pointer(csource)))
+	}
+}
@@ -0,0 +1,134 @@
+package glib
+
+import (
+	"sync"
+	"time"
+)
+
+// SourceHandle controls a timeout source created by [After] or [Every]. It is safe to use from
+// any goroutine.
+//
+// The callback is registered in the userdata registry while the source is active and is released
+// as soon as the source is stopped or has fired for the last time.
+type SourceHandle struct {
+	mu sync.Mutex
+
+	mainContext *MainContext
+	interval    time.Duration
+	repeat      bool
+	fn          func()
+
+	// source is the currently attached source, nil if the handle is not active
+	source *Source
+}
+
+// After calls fn once on the thread dispatching mainContext after d elapsed. A nil mainContext
+// refers to the global default main context. The duration is rounded up to milliseconds.
+func After(mainContext *MainContext, d time.Duration, fn func()) *SourceHandle {
+	return newSourceHandle(mainContext, d, false, fn)
+}
+
+// Every calls fn on the thread dispatching mainContext every time d elapsed, until the returned
+// handle is stopped. A nil mainContext refers to the global default main context. The duration is
+// rounded up to milliseconds and is at least 1 ms.
+func Every(mainContext *MainContext, d time.Duration, fn func()) *SourceHandle {
+	return newSourceHandle(mainContext, d, true, fn)
+}
+
+func newSourceHandle(mainContext *MainContext, d time.Duration, repeat bool, fn func()) *SourceHandle {
+	if mainContext == nil {
+		mainContext = MainContextDefault()
+	}
+
+	h := &SourceHandle{
+		mainContext: mainContext,
+		interval:    d,
+		repeat:      repeat,
+		fn:          fn,
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.start()
+
+	return h
+}
+
+// start attaches a new timeout source, h.mu must be held.
+func (h *SourceHandle) start() {
+	source := NewTimeoutSource(h.timeout())
+
+	source.SetCallback(func() bool {
+		h.mu.Lock()
+
+		if h.source != source {
+			// stopped or reset while this dispatch was pending
+			h.mu.Unlock()
+			return false
+		}
+
+		if !h.repeat {
+			h.source = nil
+		}
+
+		h.mu.Unlock()
+
+		h.fn()
+
+		return h.repeat
+	})
+
+	source.Attach(h.mainContext)
+
+	h.source = source
+}
+
+// timeout returns the interval in milliseconds, rounded up. A repeating source waits at least 1 ms,
+// because a zero timeout would dispatch it in every iteration of the main context.
+func (h *SourceHandle) timeout() uint {
+	ms := max(h.interval/time.Millisecond, 0)
+
+	if h.interval%time.Millisecond > 0 {
+		ms++
+	}
+
+	if h.repeat {
+		ms = max(ms, 1)
+	}
+
+	return uint(ms)
+}
+
+// Stop stops the source and releases the callback. It returns false if the source already fired
+// or was stopped before. fn may still run once after Stop returned if it was already being dispatched.
+func (h *SourceHandle) Stop() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.source == nil {
+		return false
+	}
+
+	h.source.Destroy()
+	h.source = nil
+
+	return true
+}
+
+// Reset restarts the countdown of the source with its original duration, rescheduling it if it already
+// fired or was stopped. It returns true if the source was active before.
+func (h *SourceHandle) Reset() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	active := h.source != nil
+
+	if active {
+		h.source.Destroy()
+	}
+
+	h.start()
+
+	return active
+}
@@ -0,0 +1,669 @@
+package glib
+
+// #cgo pkg-config: glib-2.0
+// #cgo CFLAGS: -Wno-deprecated-declarations
+// #include <stdlib.h>
+// #include <glib.h>
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// Variant wraps GVariant
+//
+// A Variant is an immutable, reference counted container for a value and its
+// type. Variants returned by the bindings are never floating, the floating
+// reference of newly created GVariants is always sunk when they are converted to go.
+//
+// see also https://docs.gtk.org/glib/struct.Variant.html
+type Variant struct {
+	*variant
+}
+
+// variant is the struct that's finalized
+type variant struct {
+	native *C.GVariant
+}
+
+// UnsafeVariantFromGlibBorrow is used to convert raw C.GVariant pointers to go without touching any references. This is used by the bindings internally.
+func UnsafeVariantFromGlibBorrow(p unsafe.Pointer) *Variant {
+	if p == nil {
+		return nil
+	}
+	return &Variant{&variant{(*C.GVariant)(p)}}
+}
+
+// UnsafeVariantFromGlibNone is used to convert raw C.GVariant pointers to go without transferring ownership. If the
+// GVariant is floating, then the floating reference is sunk, otherwise a new reference is taken. This is used by the bindings internally.
+func UnsafeVariantFromGlibNone(p unsafe.Pointer) *Variant {
+	wrapped := UnsafeVariantFromGlibBorrow(p)
+	if wrapped == nil {
+		return nil
+	}
+
+	// if the variant was floating this removes the floating ref.
+	// if not, then this is equivalent to g_variant_ref
+	C.g_variant_ref_sink(wrapped.native)
+
+	runtime.SetFinalizer(
+		wrapped.variant,
+		func(intern *variant) {
+			C.g_variant_unref(intern.native)
+		},
+	)
+	return wrapped
+}
+
+// UnsafeVariantFromGlibFull is used to convert raw C.GVariant pointers to go while taking ownership. If the
+// GVariant is floating, then it is converted into a normal reference. This is used by the bindings internally.
+func UnsafeVariantFromGlibFull(p unsafe.Pointer) *Variant {
+	wrapped := UnsafeVariantFromGlibBorrow(p)
+	if wrapped == nil {
+		return nil
+	}
+
+	// no-op if the variant is not floating
+	C.g_variant_take_ref(wrapped.native)
+
+	runtime.SetFinalizer(
+		wrapped.variant,
+		func(intern *variant) {
+			C.g_variant_unref(intern.native)
+		},
+	)
+	return wrapped
+}
+
+// UnsafeVariantRef increases the refcount on the underlying resource.
+//
+// When this is called without an associated call to [UnsafeVariantUnref], then [Variant] will leak memory.
+func UnsafeVariantRef(v *Variant) {
+	C.g_variant_ref(v.native)
+}
+
+// UnsafeVariantUnref unrefs/frees the underlying resource. This can be used to remove the instance before the GC decides to do so.
+//
+// After this is called, no other method on [Variant] is expected to work anymore.
+func UnsafeVariantUnref(v *Variant) {
+	C.g_variant_unref(v.native)
+	runtime.SetFinalizer(v.variant, nil)
+}
+
+// UnsafeVariantToGlibNone returns the underlying C pointer. This is used by the bindings internally.
+func UnsafeVariantToGlibNone(v *Variant) unsafe.Pointer {
+	if v == nil {
+		return nil
+	}
+	return unsafe.Pointer(v.native)
+}
+
+// UnsafeVariantToGlibFull returns the underlying C pointer with an additional reference that is owned by the receiver.
+// This is used by the bindings internally.
+func UnsafeVariantToGlibFull(v *Variant) unsafe.Pointer {
+	if v == nil {
+		return nil
+	}
+
+	C.g_variant_ref(v.native)
+
+	return unsafe.Pointer(v.native)
+}
+
+// newVariant wraps a newly created (floating) GVariant.
+func newVariant(p *C.GVariant) *Variant {
+	return UnsafeVariantFromGlibNone(unsafe.Pointer(p))
+}
+
+// NewVariantBoolean wraps g_variant_new_boolean
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_boolean.html
+func NewVariantBoolean(value bool) *Variant {
+	var cvalue C.gboolean
+	if value {
+		cvalue = C.TRUE
+	}
+	return newVariant(C.g_variant_new_boolean(cvalue))
+}
+
+// NewVariantByte wraps g_variant_new_byte
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_byte.html
+func NewVariantByte(value uint8) *Variant {
+	return newVariant(C.g_variant_new_byte(C.guint8(value)))
+}
+
+// NewVariantInt16 wraps g_variant_new_int16
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_int16.html
+func NewVariantInt16(value int16) *Variant {
+	return newVariant(C.g_variant_new_int16(C.gint16(value)))
+}
+
+// NewVariantUint16 wraps g_variant_new_uint16
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_uint16.html
+func NewVariantUint16(value uint16) *Variant {
+	return newVariant(C.g_variant_new_uint16(C.guint16(value)))
+}
+
+// NewVariantInt32 wraps g_variant_new_int32
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_int32.html
+func NewVariantInt32(value int32) *Variant {
+	return newVariant(C.g_variant_new_int32(C.gint32(value)))
+}
+
+// NewVariantUint32 wraps g_variant_new_uint32
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_uint32.html
+func NewVariantUint32(value uint32) *Variant {
+	return newVariant(C.g_variant_new_uint32(C.guint32(value)))
+}
+
+// NewVariantInt64 wraps g_variant_new_int64
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_int64.html
+func NewVariantInt64(value int64) *Variant {
+	return newVariant(C.g_variant_new_int64(C.gint64(value)))
+}
+
+// NewVariantUint64 wraps g_variant_new_uint64
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_uint64.html
+func NewVariantUint64(value uint64) *Variant {
+	return newVariant(C.g_variant_new_uint64(C.guint64(value)))
+}
+
+// NewVariantHandle wraps g_variant_new_handle
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_handle.html
+func NewVariantHandle(value int32) *Variant {
+	return newVariant(C.g_variant_new_handle(C.gint32(value)))
+}
+
+// NewVariantDouble wraps g_variant_new_double
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_double.html
+func NewVariantDouble(value float64) *Variant {
+	return newVariant(C.g_variant_new_double(C.gdouble(value)))
+}
+
+// NewVariantString wraps g_variant_new_string
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_string.html
+func NewVariantString(value string) *Variant {
+	cstr := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return newVariant(C.g_variant_new_string((*C.gchar)(cstr)))
+}
+
+// NewVariantObjectPath wraps g_variant_new_object_path. The given path must be a valid
+// D-Bus object path, see [VariantIsObjectPath].
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_object_path.html
+func NewVariantObjectPath(path string) *Variant {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return newVariant(C.g_variant_new_object_path((*C.gchar)(cstr)))
+}
+
+// NewVariantSignature wraps g_variant_new_signature. The given signature must be a valid
+// D-Bus type signature, see [VariantIsSignature].
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_signature.html
+func NewVariantSignature(signature string) *Variant {
+	cstr := C.CString(signature)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return newVariant(C.g_variant_new_signature((*C.gchar)(cstr)))
+}
+
+// NewVariantBytestring wraps g_variant_new_bytestring
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_bytestring.html
+func NewVariantBytestring(value []byte) *Variant {
+	cstr := C.CString(string(value))
+	defer C.free(unsafe.Pointer(cstr))
+
+	return newVariant(C.g_variant_new_bytestring((*C.gchar)(cstr)))
+}
+
+// NewVariantStrv wraps g_variant_new_strv
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_strv.html
+func NewVariantStrv(strv []string) *Variant {
+	cstrv := make([]*C.gchar, len(strv))
+
+	for i, s := range strv {
+		cstrv[i] = (*C.gchar)(C.CString(s))
+		defer C.free(unsafe.Pointer(cstrv[i]))
+	}
+
+	return newVariant(C.g_variant_new_strv(unsafe.SliceData(cstrv), C.gssize(len(cstrv))))
+}
+
+// NewVariantVariant wraps g_variant_new_variant and boxes the given value.
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_variant.html
+func NewVariantVariant(value *Variant) *Variant {
+	v := newVariant(C.g_variant_new_variant(value.native))
+	runtime.KeepAlive(value)
+	return v
+}
+
+// NewVariantTuple wraps g_variant_new_tuple
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_tuple.html
+func NewVariantTuple(children []*Variant) *Variant {
+	cchildren := variantsToGlibNone(children)
+
+	v := newVariant(C.g_variant_new_tuple(unsafe.SliceData(cchildren), C.gsize(len(cchildren))))
+	runtime.KeepAlive(children)
+	return v
+}
+
+// NewVariantArray wraps g_variant_new_array. childType may be nil if children is not empty, all children must
+// be of the same type. A dictionary is an array of dict entries, see [NewVariantDictEntry].
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_array.html
+func NewVariantArray(childType *VariantType, children []*Variant) *Variant {
+	cchildren := variantsToGlibNone(children)
+
+	v := newVariant(C.g_variant_new_array(
+		(*C.GVariantType)(UnsafeVariantTypeToGlibNone(childType)),
+		unsafe.SliceData(cchildren),
+		C.gsize(len(cchildren)),
+	))
+	runtime.KeepAlive(childType)
+	runtime.KeepAlive(children)
+	return v
+}
+
+// NewVariantDictEntry wraps g_variant_new_dict_entry. The key must be a basic type.
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_dict_entry.html
+func NewVariantDictEntry(key, value *Variant) *Variant {
+	v := newVariant(C.g_variant_new_dict_entry(key.native, value.native))
+	runtime.KeepAlive(key)
+	runtime.KeepAlive(value)
+	return v
+}
+
+// NewVariantMaybe wraps g_variant_new_maybe. childType may be nil if child is non nil. A nil child
+// creates a "Nothing" value.
+//
+// see also https://docs.gtk.org/glib/ctor.Variant.new_maybe.html
+func NewVariantMaybe(childType *VariantType, child *Variant) *Variant {
+	v := newVariant(C.g_variant_new_maybe(
+		(*C.GVariantType)(UnsafeVariantTypeToGlibNone(childType)),
+		(*C.GVariant)(UnsafeVariantToGlibNone(child)),
+	))
+	runtime.KeepAlive(childType)
+	runtime.KeepAlive(child)
+	return v
+}
+
+func variantsToGlibNone(variants []*Variant) []*C.GVariant {
+	cvariants := make([]*C.GVariant, len(variants))
+
+	for i, v := range variants {
+		cvariants[i] = v.native
+	}
+
+	return cvariants
+}
+
+// VariantIsObjectPath wraps g_variant_is_object_path
+//
+// see also https://docs.gtk.org/glib/type_func.Variant.is_object_path.html
+func VariantIsObjectPath(str string) bool {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return C.g_variant_is_object_path((*C.gchar)(cstr)) != 0
+}
+
+// VariantIsSignature wraps g_variant_is_signature
+//
+// see also https://docs.gtk.org/glib/type_func.Variant.is_signature.html
+func VariantIsSignature(str string) bool {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return C.g_variant_is_signature((*C.gchar)(cstr)) != 0
+}
+
+// Type wraps g_variant_get_type and returns an owned copy of the type.
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_type.html
+func (v *Variant) Type() *VariantType {
+	t := UnsafeVariantTypeFromGlibNone(unsafe.Pointer(C.g_variant_get_type(v.native)))
+	runtime.KeepAlive(v)
+	return t
+}
+
+// TypeString wraps g_variant_get_type_string
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_type_string.html
+func (v *Variant) TypeString() string {
+	s := C.GoString((*C.char)(C.g_variant_get_type_string(v.native)))
+	runtime.KeepAlive(v)
+	return s
+}
+
+// IsOfType wraps g_variant_is_of_type
+//
+// see also https://docs.gtk.org/glib/method.Variant.is_of_type.html
+func (v *Variant) IsOfType(typ *VariantType) bool {
+	b := C.g_variant_is_of_type(v.native, (*C.GVariantType)(UnsafeVariantTypeToGlibNone(typ))) != 0
+	runtime.KeepAlive(v)
+	runtime.KeepAlive(typ)
+	return b
+}
+
+// IsContainer wraps g_variant_is_container
+//
+// see also https://docs.gtk.org/glib/method.Variant.is_container.html
+func (v *Variant) IsContainer() bool {
+	b := C.g_variant_is_container(v.native) != 0
+	runtime.KeepAlive(v)
+	return b
+}
+
+// Classify wraps g_variant_classify
+//
+// see also https://docs.gtk.org/glib/method.Variant.classify.html
+func (v *Variant) Classify() VariantClass {
+	c := VariantClass(C.g_variant_classify(v.native))
+	runtime.KeepAlive(v)
+	return c
+}
+
+// GetBoolean wraps g_variant_get_boolean
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_boolean.html
+func (v *Variant) GetBoolean() bool {
+	b := C.g_variant_get_boolean(v.native) != 0
+	runtime.KeepAlive(v)
+	return b
+}
+
+// GetByte wraps g_variant_get_byte
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_byte.html
+func (v *Variant) GetByte() uint8 {
+	r := uint8(C.g_variant_get_byte(v.native))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetInt16 wraps g_variant_get_int16
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_int16.html
+func (v *Variant) GetInt16() int16 {
+	r := int16(C.g_variant_get_int16(v.native))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetUint16 wraps g_variant_get_uint16
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_uint16.html
+func (v *Variant) GetUint16() uint16 {
+	r := uint16(C.g_variant_get_uint16(v.native))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetInt32 wraps g_variant_get_int32
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_int32.html
+func (v *Variant) GetInt32() int32 {
+	r := int32(C.g_variant_get_int32(v.native))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetUint32 wraps g_variant_get_uint32
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_uint32.html
+func (v *Variant) GetUint32() uint32 {
+	r := uint32(C.g_variant_get_uint32(v.native))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetInt64 wraps g_variant_get_int64
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_int64.html
+func (v *Variant) GetInt64() int64 {
+	r := int64(C.g_variant_get_int64(v.native))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetUint64 wraps g_variant_get_uint64
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_uint64.html
+func (v *Variant) GetUint64() uint64 {
+	r := uint64(C.g_variant_get_uint64(v.native))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetHandle wraps g_variant_get_handle
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_handle.html
+func (v *Variant) GetHandle() int32 {
+	r := int32(C.g_variant_get_handle(v.native))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetDouble wraps g_variant_get_double
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_double.html
+func (v *Variant) GetDouble() float64 {
+	r := float64(C.g_variant_get_double(v.native))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetString wraps g_variant_get_string. It is valid for strings, object paths and signatures.
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_string.html
+func (v *Variant) GetString() string {
+	var length C.gsize
+	cstr := C.g_variant_get_string(v.native, &length)
+	s := C.GoStringN((*C.char)(cstr), C.int(length))
+	runtime.KeepAlive(v)
+	return s
+}
+
+// GetBytestring wraps g_variant_get_bytestring. The returned slice does not contain the
+// trailing nul byte.
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_bytestring.html
+func (v *Variant) GetBytestring() []byte {
+	cstr := C.g_variant_get_bytestring(v.native)
+	b := []byte(C.GoString((*C.char)(cstr)))
+	runtime.KeepAlive(v)
+	return b
+}
+
+// GetStrv wraps g_variant_get_strv. It is valid for string arrays, object path arrays and signature arrays.
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_strv.html
+func (v *Variant) GetStrv() []string {
+	var length C.gsize
+	cstrv := C.g_variant_get_strv(v.native, &length)
+	defer C.g_free(C.gpointer(cstrv))
+	runtime.KeepAlive(v)
+
+	if length == 0 {
+		return nil
+	}
+
+	strv := make([]string, length)
+	for i, cstr := range unsafe.Slice(cstrv, length) {
+		strv[i] = C.GoString((*C.char)(cstr))
+	}
+
+	runtime.KeepAlive(v)
+
+	return strv
+}
+
+// GetVariant wraps g_variant_get_variant and unboxes the contained value.
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_variant.html
+func (v *Variant) GetVariant() *Variant {
+	r := UnsafeVariantFromGlibFull(unsafe.Pointer(C.g_variant_get_variant(v.native)))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// GetMaybe wraps g_variant_get_maybe. It returns nil if the maybe contains no value.
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_maybe.html
+func (v *Variant) GetMaybe() *Variant {
+	r := UnsafeVariantFromGlibFull(unsafe.Pointer(C.g_variant_get_maybe(v.native)))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// NChildren wraps g_variant_n_children. It is valid for tuples, arrays, maybes and dict entries.
+//
+// see also https://docs.gtk.org/glib/method.Variant.n_children.html
+func (v *Variant) NChildren() uint {
+	n := uint(C.g_variant_n_children(v.native))
+	runtime.KeepAlive(v)
+	return n
+}
+
+// GetChildValue wraps g_variant_get_child_value
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_child_value.html
+func (v *Variant) GetChildValue(index uint) *Variant {
+	r := UnsafeVariantFromGlibFull(unsafe.Pointer(C.g_variant_get_child_value(v.native, C.gsize(index))))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// Children returns all children of the container variant.
+func (v *Variant) Children() []*Variant {
+	n := v.NChildren()
+
+	children := make([]*Variant, n)
+	for i := range n {
+		children[i] = v.GetChildValue(i)
+	}
+
+	return children
+}
+
+// LookupValue wraps g_variant_lookup_value and looks up key in a dictionary of type a{s*} or a{o*}.
+// expectedType can be nil. It returns nil if the key was not found or does not have the expected type.
+//
+// see also https://docs.gtk.org/glib/method.Variant.lookup_value.html
+func (v *Variant) LookupValue(key string, expectedType *VariantType) *Variant {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+
+	r := UnsafeVariantFromGlibFull(unsafe.Pointer(C.g_variant_lookup_value(
+		v.native,
+		(*C.gchar)(cstr),
+		(*C.GVariantType)(UnsafeVariantTypeToGlibNone(expectedType)),
+	)))
+	runtime.KeepAlive(v)
+	runtime.KeepAlive(expectedType)
+	return r
+}
+
+// Equal wraps g_variant_equal
+//
+// see also https://docs.gtk.org/glib/method.Variant.equal.html
+func (v *Variant) Equal(other *Variant) bool {
+	b := C.g_variant_equal(C.gconstpointer(v.native), C.gconstpointer(other.native)) != 0
+	runtime.KeepAlive(v)
+	runtime.KeepAlive(other)
+	return b
+}
+
+// Hash wraps g_variant_hash. It is only valid for basic types.
+//
+// see also https://docs.gtk.org/glib/method.Variant.hash.html
+func (v *Variant) Hash() uint {
+	h := uint(C.g_variant_hash(C.gconstpointer(v.native)))
+	runtime.KeepAlive(v)
+	return h
+}
+
+// NormalForm wraps g_variant_get_normal_form
+//
+// see also https://docs.gtk.org/glib/method.Variant.get_normal_form.html
+func (v *Variant) NormalForm() *Variant {
+	r := UnsafeVariantFromGlibFull(unsafe.Pointer(C.g_variant_get_normal_form(v.native)))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// Byteswap wraps g_variant_byteswap
+//
+// see also https://docs.gtk.org/glib/method.Variant.byteswap.html
+func (v *Variant) Byteswap() *Variant {
+	r := UnsafeVariantFromGlibFull(unsafe.Pointer(C.g_variant_byteswap(v.native)))
+	runtime.KeepAlive(v)
+	return r
+}
+
+// Print wraps g_variant_print
+//
+// see also https://docs.gtk.org/glib/method.Variant.print.html
+func (v *Variant) Print(typeAnnotate bool) string {
+	var cannotate C.gboolean
+	if typeAnnotate {
+		cannotate = C.TRUE
+	}
+
+	cstr := C.g_variant_print(v.native, cannotate)
+	defer C.g_free(C.gpointer(cstr))
+	runtime.KeepAlive(v)
+
+	return C.GoString((*C.char)(cstr))
+}
+
+// String implements fmt.Stringer by printing the variant without type annotations.
+func (v *Variant) String() string {
+	return v.Print(false)
+}
+
+// ParseVariant wraps g_variant_parse and parses the text format produced by [Variant.Print].
+// typ may be nil, in which case the type is inferred from the text.
+//
+// see also https://docs.gtk.org/glib/type_func.Variant.parse.html
+func ParseVariant(typ *VariantType, text string) (*Variant, error) {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var _cerr *C.GError
+
+	cret := C.g_variant_parse(
+		(*C.GVariantType)(UnsafeVariantTypeToGlibNone(typ)),
+		(*C.gchar)(cstr),
+		nil,
+		nil,
+		&_cerr,
+	)
+	runtime.KeepAlive(typ)
+
+	if _cerr != nil {
+		return nil, UnsafeErrorFromGlibFull(unsafe.Pointer(_cerr))
+	}
+
+	return UnsafeVariantFromGlibFull(unsafe.Pointer(cret)), nil
+}
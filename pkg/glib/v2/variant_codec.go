@@ -0,0 +1,741 @@
+package glib
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// VariantObjectPath is a string that is encoded as a D-Bus object path ("o") by [MarshalVariant].
+type VariantObjectPath string
+
+// VariantSignature is a string that is encoded as a D-Bus type signature ("g") by [MarshalVariant].
+type VariantSignature string
+
+// VariantTuple can be embedded into a struct to encode the struct as a tuple instead of an a{sv} dictionary.
+//
+//	type Point struct {
+//		glib.VariantTuple
+//		X, Y int32
+//	}
+//
+// encodes as "(ii)".
+type VariantTuple struct{}
+
+// VariantUnsupportedTypeError is returned by [MarshalVariant], [UnmarshalVariant] and [VariantTypeOf]
+// when a go type cannot be represented as a GVariant.
+type VariantUnsupportedTypeError struct {
+	Type reflect.Type
+}
+
+func (e *VariantUnsupportedTypeError) Error() string {
+	return "glib: unsupported type for variant: " + e.Type.String()
+}
+
+// VariantUnmarshalTypeError is returned by [UnmarshalVariant] when the type of the variant
+// does not match the type derived from the go value.
+type VariantUnmarshalTypeError struct {
+	Value string       // type string of the variant
+	Type  reflect.Type // type of the go value that could not be assigned to
+	Field string       // the full path from the root to the field, if any
+}
+
+func (e *VariantUnmarshalTypeError) Error() string {
+	if e.Field != "" {
+		return "glib: cannot unmarshal variant of type " + e.Value + " into go struct field " + e.Field + " of type " + e.Type.String()
+	}
+	return "glib: cannot unmarshal variant of type " + e.Value + " into go value of type " + e.Type.String()
+}
+
+var (
+	variantPtrType    = reflect.TypeFor[*Variant]()
+	variantTupleType  = reflect.TypeFor[VariantTuple]()
+	objectPathType    = reflect.TypeFor[VariantObjectPath]()
+	signatureType     = reflect.TypeFor[VariantSignature]()
+	variantSignatures sync.Map // map[reflect.Type]string
+	variantFields     sync.Map // map[reflect.Type]*variantStructInfo
+)
+
+// MarshalVariant returns the GVariant encoding of v. The variant type is derived from the go type of v:
+//
+//   - bool, uint8, int16, uint16, int32, uint32, int64, uint64, float64 and string map to
+//     b, y, n, q, i, u, x, t, d and s. int and uint map to x and t, float32 maps to d.
+//   - [VariantObjectPath] and [VariantSignature] map to o and g.
+//   - slices and arrays map to arrays, maps map to dictionaries. Map keys must be basic types.
+//   - pointers map to maybe types, a nil pointer is encoded as Nothing.
+//   - *Variant and empty interfaces map to v, the contained value is boxed.
+//   - structs map to a{sv} dictionaries, or to tuples if they embed [VariantTuple].
+//
+// Struct fields are controlled by the "variant" struct tag, similar to encoding/json. The tag
+// value is the dictionary key, optionally followed by ",omitempty" to skip zero values.
+// Fields tagged with "-" and unexported fields are ignored. Tuples ignore the key.
+//
+// A *Variant passed to MarshalVariant is returned unchanged.
+func MarshalVariant(v any) (*Variant, error) {
+	if variant, ok := v.(*Variant); ok {
+		if variant == nil {
+			return nil, errors.New("glib: cannot marshal nil *Variant")
+		}
+		return variant, nil
+	}
+
+	if v == nil {
+		return nil, errors.New("glib: cannot marshal nil into a variant")
+	}
+
+	return encodeVariant(reflect.ValueOf(v), "")
+}
+
+// UnmarshalVariant decodes the variant into the value pointed to by v. The variant type must match the
+// type derived from v, see [MarshalVariant] for the mapping. Missing dictionary keys leave the
+// corresponding struct fields untouched, unknown keys are ignored.
+//
+// Empty interfaces receive bool, uint8, int16, uint16, int32, uint32, int64, uint64, float64, string,
+// [VariantObjectPath], [VariantSignature], []any for arrays and tuples, map[string]any or map[any]any
+// for dictionaries, or nil for Nothing.
+func UnmarshalVariant(variant *Variant, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("glib: UnmarshalVariant requires a non-nil pointer, got %T", v)
+	}
+
+	if variant == nil {
+		return errors.New("glib: cannot unmarshal nil variant")
+	}
+
+	return decodeVariant(variant, rv.Elem(), "")
+}
+
+// VariantTypeOf returns the variant type that [MarshalVariant] produces for values of the given go type.
+func VariantTypeOf(t reflect.Type) (*VariantType, error) {
+	sig, err := variantSignature(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewVariantType(sig), nil
+}
+
+// variantSignature returns the variant type string for the given go type.
+func variantSignature(t reflect.Type) (string, error) {
+	if sig, ok := variantSignatures.Load(t); ok {
+		return sig.(string), nil
+	}
+
+	sig, err := computeVariantSignature(t, map[reflect.Type]bool{})
+	if err != nil {
+		return "", err
+	}
+
+	variantSignatures.Store(t, sig)
+
+	return sig, nil
+}
+
+func computeVariantSignature(t reflect.Type, visiting map[reflect.Type]bool) (string, error) {
+	switch t {
+	case variantPtrType:
+		return "v", nil
+	case objectPathType:
+		return "o", nil
+	case signatureType:
+		return "g", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "b", nil
+	case reflect.Uint8:
+		return "y", nil
+	case reflect.Int16:
+		return "n", nil
+	case reflect.Uint16:
+		return "q", nil
+	case reflect.Int32:
+		return "i", nil
+	case reflect.Uint32:
+		return "u", nil
+	case reflect.Int64, reflect.Int:
+		return "x", nil
+	case reflect.Uint64, reflect.Uint:
+		return "t", nil
+	case reflect.Float32, reflect.Float64:
+		return "d", nil
+	case reflect.String:
+		return "s", nil
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "v", nil
+		}
+	}
+
+	if t.Kind() == reflect.Struct && !variantStructFields(t).tuple {
+		// the fields of a{sv} dictionaries are boxed, so recursive structs are fine
+		return "a{sv}", nil
+	}
+
+	// containers and tuples contain their elements by type, so a recursive type cannot be expressed
+	if visiting[t] {
+		return "", &VariantUnsupportedTypeError{t}
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		elem, err := computeVariantSignature(t.Elem(), visiting)
+		if err != nil {
+			return "", err
+		}
+		return "m" + elem, nil
+	case reflect.Slice, reflect.Array:
+		elem, err := computeVariantSignature(t.Elem(), visiting)
+		if err != nil {
+			return "", err
+		}
+		return "a" + elem, nil
+	case reflect.Map:
+		key, err := computeVariantSignature(t.Key(), visiting)
+		if err != nil {
+			return "", err
+		}
+		if !isBasicVariantSignature(key) {
+			return "", &VariantUnsupportedTypeError{t}
+		}
+		value, err := computeVariantSignature(t.Elem(), visiting)
+		if err != nil {
+			return "", err
+		}
+		return "a{" + key + value + "}", nil
+	case reflect.Struct:
+		var sb strings.Builder
+		sb.WriteByte('(')
+		for _, f := range variantStructFields(t).fields {
+			field, err := computeVariantSignature(f.typ, visiting)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(field)
+		}
+		sb.WriteByte(')')
+
+		return sb.String(), nil
+	}
+
+	return "", &VariantUnsupportedTypeError{t}
+}
+
+func isBasicVariantSignature(sig string) bool {
+	return len(sig) == 1 && strings.Contains("bynqiuxtdsogh", sig)
+}
+
+type variantStructInfo struct {
+	tuple  bool
+	fields []variantField
+}
+
+type variantField struct {
+	name      string
+	index     int
+	typ       reflect.Type
+	omitEmpty bool
+}
+
+// variantStructFields parses the struct tags of the given struct type.
+func variantStructFields(t reflect.Type) *variantStructInfo {
+	if info, ok := variantFields.Load(t); ok {
+		return info.(*variantStructInfo)
+	}
+
+	info := &variantStructInfo{}
+
+	for i := range t.NumField() {
+		sf := t.Field(i)
+
+		if sf.Anonymous && sf.Type == variantTupleType {
+			info.tuple = true
+			continue
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+
+		tag := sf.Tag.Get("variant")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+
+		info.fields = append(info.fields, variantField{
+			name:      name,
+			index:     i,
+			typ:       sf.Type,
+			omitEmpty: slices.Contains(strings.Split(opts, ","), "omitempty"),
+		})
+	}
+
+	actual, _ := variantFields.LoadOrStore(t, info)
+
+	return actual.(*variantStructInfo)
+}
+
+func encodeVariant(rv reflect.Value, field string) (*Variant, error) {
+	t := rv.Type()
+
+	if t == variantPtrType {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("glib: cannot marshal nil *Variant in %s", fieldOrValue(field))
+		}
+		return NewVariantVariant(rv.Interface().(*Variant)), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return NewVariantBoolean(rv.Bool()), nil
+	case reflect.Uint8:
+		return NewVariantByte(uint8(rv.Uint())), nil
+	case reflect.Int16:
+		return NewVariantInt16(int16(rv.Int())), nil
+	case reflect.Uint16:
+		return NewVariantUint16(uint16(rv.Uint())), nil
+	case reflect.Int32:
+		return NewVariantInt32(int32(rv.Int())), nil
+	case reflect.Uint32:
+		return NewVariantUint32(uint32(rv.Uint())), nil
+	case reflect.Int64, reflect.Int:
+		return NewVariantInt64(rv.Int()), nil
+	case reflect.Uint64, reflect.Uint:
+		return NewVariantUint64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return NewVariantDouble(rv.Float()), nil
+	case reflect.String:
+		switch t {
+		case objectPathType:
+			if !VariantIsObjectPath(rv.String()) {
+				return nil, fmt.Errorf("glib: invalid object path %q in %s", rv.String(), fieldOrValue(field))
+			}
+			return NewVariantObjectPath(rv.String()), nil
+		case signatureType:
+			if !VariantIsSignature(rv.String()) {
+				return nil, fmt.Errorf("glib: invalid signature %q in %s", rv.String(), fieldOrValue(field))
+			}
+			return NewVariantSignature(rv.String()), nil
+		}
+		return NewVariantString(rv.String()), nil
+	case reflect.Interface:
+		if t.NumMethod() != 0 {
+			break
+		}
+		if rv.IsNil() {
+			return nil, fmt.Errorf("glib: cannot marshal nil interface in %s", fieldOrValue(field))
+		}
+
+		elem := rv.Elem()
+
+		var child *Variant
+		if elem.Type() == variantPtrType {
+			// do not box twice, the variant is the dynamic value
+			child = elem.Interface().(*Variant)
+		} else {
+			var err error
+			child, err = encodeVariant(elem, field)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return NewVariantVariant(child), nil
+	case reflect.Pointer:
+		if rv.IsNil() {
+			elem, err := variantSignature(t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			return NewVariantMaybe(NewVariantType(elem), nil), nil
+		}
+
+		child, err := encodeVariant(rv.Elem(), field)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewVariantMaybe(nil, child), nil
+	case reflect.Slice, reflect.Array:
+		elem, err := variantSignature(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		children := make([]*Variant, rv.Len())
+		for i := range children {
+			children[i], err = encodeVariant(rv.Index(i), field)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return NewVariantArray(NewVariantType(elem), children), nil
+	case reflect.Map:
+		sig, err := variantSignature(t)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := rv.MapKeys()
+		slices.SortFunc(keys, compareVariantKeys)
+
+		children := make([]*Variant, len(keys))
+		for i, key := range keys {
+			k, err := encodeVariant(key, field)
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := encodeVariant(rv.MapIndex(key), field)
+			if err != nil {
+				return nil, err
+			}
+
+			children[i] = NewVariantDictEntry(k, v)
+		}
+
+		// strip the leading "a" to get the dict entry type
+		return NewVariantArray(NewVariantType(sig[1:]), children), nil
+	case reflect.Struct:
+		return encodeVariantStruct(rv, field)
+	}
+
+	return nil, &VariantUnsupportedTypeError{t}
+}
+
+func encodeVariantStruct(rv reflect.Value, field string) (*Variant, error) {
+	t := rv.Type()
+	info := variantStructFields(t)
+
+	if info.tuple {
+		// validates recursive types
+		if _, err := variantSignature(t); err != nil {
+			return nil, err
+		}
+
+		children := make([]*Variant, len(info.fields))
+		for i, f := range info.fields {
+			var err error
+			children[i], err = encodeVariant(rv.Field(f.index), joinVariantField(field, t, f.name))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return NewVariantTuple(children), nil
+	}
+
+	children := make([]*Variant, 0, len(info.fields))
+	for _, f := range info.fields {
+		fv := rv.Field(f.index)
+
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		v, err := encodeVariant(fv, joinVariantField(field, t, f.name))
+		if err != nil {
+			return nil, err
+		}
+
+		// a{sv} values are always boxed, don't box a boxed value again
+		if v.Classify() != VariantClassVariant {
+			v = NewVariantVariant(v)
+		}
+
+		children = append(children, NewVariantDictEntry(NewVariantString(f.name), v))
+	}
+
+	return NewVariantArray(NewVariantType("{sv}"), children), nil
+}
+
+func decodeVariant(variant *Variant, rv reflect.Value, field string) error {
+	t := rv.Type()
+
+	// *Variant and empty interfaces accept any variant, boxed values are unboxed
+	switch {
+	case t == variantPtrType:
+		if variant.Classify() == VariantClassVariant {
+			variant = variant.GetVariant()
+		}
+		rv.Set(reflect.ValueOf(variant))
+		return nil
+	case t.Kind() == reflect.Interface && t.NumMethod() == 0:
+		if variant.Classify() == VariantClassVariant {
+			variant = variant.GetVariant()
+		}
+		if goValue := variantToGo(variant); goValue != nil {
+			rv.Set(reflect.ValueOf(goValue))
+		} else {
+			rv.SetZero()
+		}
+		return nil
+	}
+
+	sig, err := variantSignature(t)
+	if err != nil {
+		return err
+	}
+
+	if variant.TypeString() != sig {
+		return &VariantUnmarshalTypeError{
+			Value: variant.TypeString(),
+			Type:  t,
+			Field: field,
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		rv.SetBool(variant.GetBoolean())
+	case reflect.Uint8:
+		rv.SetUint(uint64(variant.GetByte()))
+	case reflect.Int16:
+		rv.SetInt(int64(variant.GetInt16()))
+	case reflect.Uint16:
+		rv.SetUint(uint64(variant.GetUint16()))
+	case reflect.Int32:
+		rv.SetInt(int64(variant.GetInt32()))
+	case reflect.Uint32:
+		rv.SetUint(uint64(variant.GetUint32()))
+	case reflect.Int64, reflect.Int:
+		rv.SetInt(variant.GetInt64())
+	case reflect.Uint64, reflect.Uint:
+		rv.SetUint(variant.GetUint64())
+	case reflect.Float32, reflect.Float64:
+		rv.SetFloat(variant.GetDouble())
+	case reflect.String:
+		rv.SetString(variant.GetString())
+	case reflect.Pointer:
+		child := variant.GetMaybe()
+		if child == nil {
+			rv.SetZero()
+			return nil
+		}
+
+		p := reflect.New(t.Elem())
+		if err := decodeVariant(child, p.Elem(), field); err != nil {
+			return err
+		}
+		rv.Set(p)
+	case reflect.Slice:
+		n := int(variant.NChildren())
+
+		s := reflect.MakeSlice(t, n, n)
+		for i := range n {
+			if err := decodeVariant(variant.GetChildValue(uint(i)), s.Index(i), field); err != nil {
+				return err
+			}
+		}
+		rv.Set(s)
+	case reflect.Array:
+		n := int(variant.NChildren())
+		if n != t.Len() {
+			return fmt.Errorf("glib: cannot unmarshal array of %d elements into %s of type %s", n, fieldOrValue(field), t)
+		}
+
+		for i := range n {
+			if err := decodeVariant(variant.GetChildValue(uint(i)), rv.Index(i), field); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		n := variant.NChildren()
+
+		m := reflect.MakeMapWithSize(t, int(n))
+		for i := range n {
+			entry := variant.GetChildValue(i)
+
+			k := reflect.New(t.Key()).Elem()
+			if err := decodeVariant(entry.GetChildValue(0), k, field); err != nil {
+				return err
+			}
+
+			v := reflect.New(t.Elem()).Elem()
+			if err := decodeVariant(entry.GetChildValue(1), v, field); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(k, v)
+		}
+		rv.Set(m)
+	case reflect.Struct:
+		return decodeVariantStruct(variant, rv, field)
+	default:
+		return &VariantUnsupportedTypeError{t}
+	}
+
+	return nil
+}
+
+func decodeVariantStruct(variant *Variant, rv reflect.Value, field string) error {
+	t := rv.Type()
+	info := variantStructFields(t)
+
+	if info.tuple {
+		for i, f := range info.fields {
+			if err := decodeVariant(variant.GetChildValue(uint(i)), rv.Field(f.index), joinVariantField(field, t, f.name)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// the boxed values by key, the first entry wins like in g_variant_lookup_value
+	boxed := make(map[string]*Variant, variant.NChildren())
+	for _, entry := range variant.Children() {
+		key := entry.GetChildValue(0).GetString()
+		if _, ok := boxed[key]; !ok {
+			boxed[key] = entry.GetChildValue(1)
+		}
+	}
+
+	for _, f := range info.fields {
+		child, ok := boxed[f.name]
+		if !ok {
+			continue
+		}
+
+		// *Variant and empty interfaces unbox the value themselves
+		if !acceptsAnyVariant(f.typ) {
+			child = child.GetVariant()
+		}
+
+		if err := decodeVariant(child, rv.Field(f.index), joinVariantField(field, t, f.name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// acceptsAnyVariant returns true for the go types that decodeVariant assigns any variant to.
+func acceptsAnyVariant(t reflect.Type) bool {
+	return t == variantPtrType || t.Kind() == reflect.Interface && t.NumMethod() == 0
+}
+
+// variantToGo converts the variant into the default go representation used for empty interfaces.
+func variantToGo(v *Variant) any {
+	switch v.Classify() {
+	case VariantClassBoolean:
+		return v.GetBoolean()
+	case VariantClassByte:
+		return v.GetByte()
+	case VariantClassInt16:
+		return v.GetInt16()
+	case VariantClassUint16:
+		return v.GetUint16()
+	case VariantClassInt32:
+		return v.GetInt32()
+	case VariantClassUint32:
+		return v.GetUint32()
+	case VariantClassInt64:
+		return v.GetInt64()
+	case VariantClassUint64:
+		return v.GetUint64()
+	case VariantClassHandle:
+		return v.GetHandle()
+	case VariantClassDouble:
+		return v.GetDouble()
+	case VariantClassString:
+		return v.GetString()
+	case VariantClassObjectPath:
+		return VariantObjectPath(v.GetString())
+	case VariantClassSignature:
+		return VariantSignature(v.GetString())
+	case VariantClassVariant:
+		return variantToGo(v.GetVariant())
+	case VariantClassMaybe:
+		child := v.GetMaybe()
+		if child == nil {
+			return nil
+		}
+		return variantToGo(child)
+	case VariantClassArray:
+		if !strings.HasPrefix(v.TypeString(), "a{") {
+			return variantChildrenToGo(v)
+		}
+
+		if strings.HasPrefix(v.TypeString(), "a{s") {
+			m := make(map[string]any, v.NChildren())
+			for _, entry := range v.Children() {
+				m[entry.GetChildValue(0).GetString()] = variantToGo(entry.GetChildValue(1))
+			}
+			return m
+		}
+
+		m := make(map[any]any, v.NChildren())
+		for _, entry := range v.Children() {
+			m[variantToGo(entry.GetChildValue(0))] = variantToGo(entry.GetChildValue(1))
+		}
+		return m
+	case VariantClassTuple, VariantClassDictEntry:
+		return variantChildrenToGo(v)
+	}
+
+	return nil
+}
+
+func variantChildrenToGo(v *Variant) []any {
+	children := v.Children()
+
+	values := make([]any, len(children))
+	for i, child := range children {
+		values[i] = variantToGo(child)
+	}
+
+	return values
+}
+
+func compareVariantKeys(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Bool:
+		switch {
+		case a.Bool() == b.Bool():
+			return 0
+		case a.Bool():
+			return 1
+		default:
+			return -1
+		}
+	case reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String())
+	}
+
+	return 0
+}
+
+func joinVariantField(parent string, t reflect.Type, name string) string {
+	if parent == "" {
+		return t.Name() + "." + name
+	}
+	return parent + "." + name
+}
+
+func fieldOrValue(field string) string {
+	if field == "" {
+		return "value"
+	}
+	return "field " + field
+}
@@ -0,0 +1,163 @@
+package glib
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type variantTestPoint struct {
+	VariantTuple
+	X, Y int32
+	Name string
+}
+
+type variantTestOptions struct {
+	Title   string            `variant:"title"`
+	Count   uint32            `variant:"count,omitempty"`
+	Tags    []string          `variant:"tags"`
+	Extra   *Variant          `variant:"extra"`
+	Any     any               `variant:"any"`
+	Labels  map[string]int32  `variant:"labels"`
+	Parent  *variantTestPoint `variant:"parent"`
+	Ignored string            `variant:"-"`
+}
+
+type variantTestSlice []variantTestSlice
+
+type variantTestMap map[string]variantTestMap
+
+type variantTestPointer *variantTestPointer
+
+type variantTestArray [2]variantTestSlice
+
+// roundTrip marshals in, checks the type string and unmarshals the variant into out.
+func roundTrip(t *testing.T, in any, typeString string, out any) {
+	t.Helper()
+
+	variant, err := MarshalVariant(in)
+	if err != nil {
+		t.Fatalf("MarshalVariant(%#v): %v", in, err)
+	}
+
+	if got := variant.TypeString(); got != typeString {
+		t.Fatalf("Expected type %s, got %s", typeString, got)
+	}
+
+	if err := UnmarshalVariant(variant, out); err != nil {
+		t.Fatalf("UnmarshalVariant(%s): %v", variant, err)
+	}
+
+	if got := reflect.ValueOf(out).Elem().Interface(); !reflect.DeepEqual(got, in) {
+		t.Fatalf("Expected %#v after the round trip, got %#v", in, got)
+	}
+}
+
+func TestVariantTupleRoundTrip(t *testing.T) {
+	var out variantTestPoint
+	roundTrip(t, variantTestPoint{X: 1, Y: -2, Name: "p"}, "(iis)", &out)
+
+	var outs []variantTestPoint
+	roundTrip(t, []variantTestPoint{{X: 1}, {Y: 2}}, "a(iis)", &outs)
+}
+
+func TestVariantDictRoundTrip(t *testing.T) {
+	var out map[string]int32
+	roundTrip(t, map[string]int32{"a": 1, "b": 2}, "a{si}", &out)
+
+	var outKeys map[uint32]string
+	roundTrip(t, map[uint32]string{1: "one", 2: "two"}, "a{us}", &outKeys)
+}
+
+func TestVariantMaybeRoundTrip(t *testing.T) {
+	n := int32(5)
+
+	var out *int32
+	roundTrip(t, &n, "mi", &out)
+
+	var outNil *int32
+	roundTrip(t, (*int32)(nil), "mi", &outNil)
+}
+
+func TestVariantStructRoundTrip(t *testing.T) {
+	in := variantTestOptions{
+		Title:  "title",
+		Tags:   []string{"a", "b"},
+		Extra:  NewVariantInt32(1),
+		Any:    "any",
+		Labels: map[string]int32{"x": 1},
+		Parent: &variantTestPoint{X: 3, Y: 4, Name: "parent"},
+	}
+
+	variant, err := MarshalVariant(in)
+	if err != nil {
+		t.Fatalf("MarshalVariant: %v", err)
+	}
+
+	if variant.TypeString() != "a{sv}" {
+		t.Fatalf("Expected a{sv}, got %s", variant.TypeString())
+	}
+
+	if variant.LookupValue("count", nil) != nil {
+		t.Fatalf("Expected the empty count to be omitted")
+	}
+
+	var out variantTestOptions
+	if err := UnmarshalVariant(variant, &out); err != nil {
+		t.Fatalf("UnmarshalVariant: %v", err)
+	}
+
+	if !out.Extra.Equal(in.Extra) {
+		t.Fatalf("Expected extra %s, got %s", in.Extra, out.Extra)
+	}
+
+	out.Extra, in.Extra = nil, nil
+
+	if !reflect.DeepEqual(out, in) {
+		t.Fatalf("Expected %#v after the round trip, got %#v", in, out)
+	}
+}
+
+func TestVariantStructNestedVariant(t *testing.T) {
+	nested := NewVariantVariant(NewVariantString("nested"))
+
+	variant, err := MarshalVariant(variantTestOptions{Extra: nested})
+	if err != nil {
+		t.Fatalf("MarshalVariant: %v", err)
+	}
+
+	var out variantTestOptions
+	if err := UnmarshalVariant(variant, &out); err != nil {
+		t.Fatalf("UnmarshalVariant: %v", err)
+	}
+
+	if !out.Extra.Equal(nested) {
+		t.Fatalf("Expected extra %s, got %s", nested, out.Extra)
+	}
+}
+
+func TestVariantRecursiveTypes(t *testing.T) {
+	for _, typ := range []reflect.Type{
+		reflect.TypeFor[variantTestSlice](),
+		reflect.TypeFor[variantTestMap](),
+		reflect.TypeFor[variantTestPointer](),
+		reflect.TypeFor[variantTestArray](),
+	} {
+		_, err := VariantTypeOf(typ)
+
+		var unsupported *VariantUnsupportedTypeError
+		if !errors.As(err, &unsupported) {
+			t.Fatalf("Expected VariantUnsupportedTypeError for %s, got %v", typ, err)
+		}
+	}
+
+	if _, err := MarshalVariant(variantTestSlice{nil}); err == nil {
+		t.Fatalf("Expected an error for a recursive slice")
+	}
+}
+
+func TestMarshalNilVariant(t *testing.T) {
+	if _, err := MarshalVariant((*Variant)(nil)); err == nil {
+		t.Fatalf("Expected an error for a nil *Variant")
+	}
+}
@@ -0,0 +1,72 @@
+package gobject
+
+import (
+	"log"
+	"reflect"
+	"runtime"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/userdata"
+)
+
+// #include <glib-object.h>
+// extern gpointer _goglib_gobject2_boxed_copy(gpointer);
+// extern void _goglib_gobject2_boxed_free(gpointer);
+import "C"
+
+// goBoxed is the reference counted go value of a boxed type registered with RegisterBoxed. The
+// boxed pointer that is passed to C is the userdata pointer of the goBoxed.
+type goBoxed struct {
+	value any
+	refs  atomic.Int32
+}
+
+// RegisterBoxed registers a new boxed GType with the given name, whose values are go values of type T.
+// This wraps g_boxed_type_register_static.
+//
+// After registration, values of T can be used with [NewValue], as signal arguments and as the type of
+// properties. Value.GoValue returns the value as T. The go value is stored in the userdata registry and
+// reference counted by the boxed copy and free functions, so copying the boxed value in C does not copy
+// the go value.
+//
+// Registering the same name or go type twice panics.
+func RegisterBoxed[T any](name string) Type {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	gtype := Type(C.g_boxed_type_register_static(
+		(*C.gchar)(cname),
+		C.GBoxedCopyFunc(C._goglib_gobject2_boxed_copy),
+		C.GBoxedFreeFunc(C._goglib_gobject2_boxed_free),
+	))
+
+	if gtype == TypeInvalid {
+		log.Panicf("boxed type %s could not be registered", name)
+	}
+
+	RegisterGValueMarshaler(gtype, func(p unsafe.Pointer) (any, error) {
+		ptr := ValueFromNative(p).Boxed()
+
+		if ptr == nil {
+			var zero T
+			return zero, nil
+		}
+
+		return userdata.Load(ptr).(*goBoxed).value.(T), nil
+	})
+
+	registerGoType(reflect.TypeFor[T](), registeredGoType{
+		gtype: gtype,
+		set: func(v *Value, goValue any) {
+			b := &goBoxed{value: goValue.(T)}
+			b.refs.Store(1)
+
+			// the value takes over the reference
+			C.g_value_take_boxed(v.native(), C.gconstpointer(userdata.Register(b)))
+			runtime.KeepAlive(v)
+		},
+	})
+
+	return gtype
+}
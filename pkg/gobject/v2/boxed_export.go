@@ -0,0 +1,38 @@
+package gobject
+
+import (
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core"
+	"github.com/go-gst/go-glib/pkg/core/userdata"
+)
+
+// #include <glib-object.h>
+import "C"
+
+// _goglib_gobject2_boxed_copy is the GBoxedCopyFunc of the boxed types registered with RegisterBoxed.
+// It adds a reference to the go value and returns the same pointer, also if the callback panicked,
+// because GObject does not expect NULL from a copy.
+//
+//export _goglib_gobject2_boxed_copy
+func _goglib_gobject2_boxed_copy(p C.gpointer) (ret C.gpointer) {
+	ret = p
+
+	defer core.RecoverCallback("_goglib_gobject2_boxed_copy")
+
+	userdata.Load(unsafe.Pointer(p)).(*goBoxed).refs.Add(1)
+
+	return p
+}
+
+// _goglib_gobject2_boxed_free is the GBoxedFreeFunc of the boxed types registered with RegisterBoxed.
+// It removes a reference and deletes the go value from the userdata registry when it was the last one.
+//
+//export _goglib_gobject2_boxed_free
+func _goglib_gobject2_boxed_free(p C.gpointer) {
+	defer core.RecoverCallback("_goglib_gobject2_boxed_free")
+
+	if userdata.Load(unsafe.Pointer(p)).(*goBoxed).refs.Add(-1) == 0 {
+		userdata.Delete(unsafe.Pointer(p))
+	}
+}
@@ -20,30 +20,47 @@ import "C"
 type SignalHandle uint
 
 // Connect is a wrapper around g_signal_connect_closure(). f must be a function
-// with at least one parameter matching the type it is connected to.
+// with one parameter for the instance and one for each signal parameter. The
+// parameters of f must be a matching Go equivalent type for the C callback, or
+// an interface type which the value may be packed in.
 //
-// It is optional to list the rest of the required types from GTK, as values
-// that don't fit into the function parameter will simply be ignored; however,
-// extraneous types will trigger a runtime panic. Arguments for f must be a
-// matching Go equivalent type for the C callback, or an interface type which
-// the value may be packed in. If the type is not suitable, a runtime panic will
-// occur when the signal is emitted.
+// The signature of f is validated against the signal when connecting, see
+// [ValidateSignalHandler]. A mismatch causes a runtime panic that contains the
+// location of the Connect call. Use [ObjectInstance.ConnectUnchecked] to skip
+// the validation.
 func (obj *ObjectInstance) Connect(detailedSignal string, f interface{}) SignalHandle {
-	return obj.connectClosure(false, detailedSignal, f)
+	return obj.connectClosure(false, true, detailedSignal, f)
 }
 
 // ConnectAfter is a wrapper around g_signal_connect_closure(). The difference
 // between Connect and ConnectAfter is that the latter will be invoked after the
 // default handler, not before. For more information, refer to Connect.
 func (obj *ObjectInstance) ConnectAfter(detailedSignal string, f interface{}) SignalHandle {
-	return obj.connectClosure(true, detailedSignal, f)
+	return obj.connectClosure(true, true, detailedSignal, f)
 }
 
-func (obj *ObjectInstance) connectClosure(after bool, detailedSignal string, f interface{}) SignalHandle {
-	// TODO: check if the signal is valid and if the function signature is valid for the signal handler
+// ConnectUnchecked works like Connect, but does not validate the signature of f. A mismatch
+// causes a runtime panic when the signal is emitted. This can be used in hot paths where
+// the signature of f is known to be correct.
+func (obj *ObjectInstance) ConnectUnchecked(detailedSignal string, f interface{}) SignalHandle {
+	return obj.connectClosure(false, false, detailedSignal, f)
+}
+
+// ConnectAfterUnchecked works like ConnectAfter, but does not validate the signature of f. See
+// ConnectUnchecked.
+func (obj *ObjectInstance) ConnectAfterUnchecked(detailedSignal string, f interface{}) SignalHandle {
+	return obj.connectClosure(true, false, detailedSignal, f)
+}
 
+func (obj *ObjectInstance) connectClosure(after bool, validate bool, detailedSignal string, f interface{}) SignalHandle {
 	fs := closure.NewFuncStack(f, 2)
 
+	if validate {
+		if err := obj.validateHandler(detailedSignal, fs.Value().Type()); err != nil {
+			fs.Panicf("%v", err)
+		}
+	}
+
 	cstr := C.CString(detailedSignal)
 	defer C.free(unsafe.Pointer(cstr))
 
@@ -0,0 +1,264 @@
+package gobject
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// #include <glib-object.h>
+import "C"
+
+// signalQuery contains the information of g_signal_query that is needed by the bindings.
+type signalQuery struct {
+	id         C.guint
+	name       string
+	flags      SignalFlags
+	returnType Type
+	paramTypes []Type
+}
+
+// querySignal wraps g_signal_query. The static scope flags are removed from the types.
+func querySignal(id C.guint) signalQuery {
+	var q C.GSignalQuery
+	C.g_signal_query(id, &q)
+
+	paramTypes := make([]Type, 0, q.n_params)
+	for _, pt := range unsafe.Slice(q.param_types, q.n_params) {
+		paramTypes = append(paramTypes, Type(pt&^C.G_SIGNAL_TYPE_STATIC_SCOPE))
+	}
+
+	return signalQuery{
+		id:         id,
+		name:       C.GoString((*C.char)(q.signal_name)),
+		flags:      SignalFlags(q.signal_flags),
+		returnType: Type(q.return_type &^ C.G_SIGNAL_TYPE_STATIC_SCOPE),
+		paramTypes: paramTypes,
+	}
+}
+
+// parseSignal wraps g_signal_parse_name and returns the signal id and the detail of the
+// detailed signal for the given type. ok is false if the signal does not exist.
+func parseSignal(detailedSignal string, itype Type) (id C.guint, detail C.GQuark, ok bool) {
+	cstr := C.CString(detailedSignal)
+	defer C.free(unsafe.Pointer(cstr))
+
+	ok = C.g_signal_parse_name((*C.gchar)(cstr), C.GType(itype), &id, &detail, gbool(true)) != 0
+
+	return id, detail, ok
+}
+
+// handlerCheckKey identifies a signal handler check. The result only depends on the
+// signal, the instance type and the type of the handler func.
+type handlerCheckKey struct {
+	signal C.guint
+	itype  Type
+	fType  reflect.Type
+}
+
+// handlerChecks caches the results of the signal handler checks, so connecting the same handler
+// type again is cheap. The values are error, or nil if the handler is valid.
+var handlerChecks sync.Map
+
+// ValidateSignalHandler checks whether f can be connected to the detailed signal of obj. It returns a
+// descriptive error if the signal does not exist or if the parameters or the return value of f do
+// not match the signal.
+//
+// [ObjectInstance.Connect] and [ObjectInstance.ConnectAfter] do this check automatically and panic
+// on errors. Use [ObjectInstance.ConnectUnchecked] to skip the check.
+func ValidateSignalHandler(obj Object, detailedSignal string, f any) error {
+	return obj.baseObject().validateHandler(detailedSignal, reflect.TypeOf(f))
+}
+
+func (obj *ObjectInstance) validateHandler(detailedSignal string, fType reflect.Type) error {
+	return validateHandlerForType(obj.typeFromInstance(), detailedSignal, fType)
+}
+
+// validateHandlerForType checks whether fType can be connected to the detailed signal of instances of itype.
+func validateHandlerForType(itype Type, detailedSignal string, fType reflect.Type) error {
+	if fType == nil || fType.Kind() != reflect.Func {
+		return fmt.Errorf("signal handler for %q must be a func, got %v", detailedSignal, fType)
+	}
+
+	id, _, ok := parseSignal(detailedSignal, itype)
+	if !ok {
+		return fmt.Errorf("signal %q not found for type %s", detailedSignal, itype.Name())
+	}
+
+	key := handlerCheckKey{signal: id, itype: itype, fType: fType}
+
+	if err, ok := handlerChecks.Load(key); ok {
+		if err == nil {
+			return nil
+		}
+		return err.(error)
+	}
+
+	err := checkSignalHandler(querySignal(id), itype, fType)
+
+	handlerChecks.Store(key, err)
+
+	return err
+}
+
+// checkSignalHandler compares the parameters and the return value of fType with the signal.
+func checkSignalHandler(q signalQuery, itype Type, fType reflect.Type) error {
+	if fType.IsVariadic() {
+		return fmt.Errorf("signal %s: variadic handler %s is not supported", q.name, fType)
+	}
+
+	// the first parameter is always the instance
+	if fType.NumIn() != len(q.paramTypes)+1 {
+		return fmt.Errorf("signal %s: handler %s has %d parameters, but the signal needs %d (instance and %d parameters)", q.name, fType, fType.NumIn(), len(q.paramTypes)+1, len(q.paramTypes))
+	}
+
+	if err := checkHandlerParam(fType.In(0), itype); err != nil {
+		return fmt.Errorf("signal %s: instance parameter: %w", q.name, err)
+	}
+
+	for i, t := range q.paramTypes {
+		if err := checkHandlerParam(fType.In(i+1), t); err != nil {
+			return fmt.Errorf("signal %s: parameter %d: %w", q.name, i+1, err)
+		}
+	}
+
+	if q.returnType == TypeNone || q.returnType == TypeInvalid {
+		if fType.NumOut() != 0 {
+			return fmt.Errorf("signal %s has no return value, but handler %s returns %d values", q.name, fType, fType.NumOut())
+		}
+
+		return nil
+	}
+
+	if fType.NumOut() != 1 {
+		return fmt.Errorf("signal %s returns %s, but handler %s returns %d values", q.name, q.returnType, fType, fType.NumOut())
+	}
+
+	if err := checkHandlerReturn(fType.Out(0), q.returnType); err != nil {
+		return fmt.Errorf("signal %s: return value: %w", q.name, err)
+	}
+
+	return nil
+}
+
+// checkHandlerParam checks that values of the GType t can be passed to the go type p. The
+// GValue marshaler registry is used to determine the go type that the values are marshaled to.
+func checkHandlerParam(p reflect.Type, t Type) error {
+	if p.Kind() == reflect.Interface && p.NumMethod() == 0 {
+		return nil
+	}
+
+	switch FundamentalType(t) {
+	case TypeObject, TypeInterface:
+		return checkHandlerObjectParam(p, t)
+	}
+
+	if !TypeIsValue(t) {
+		// no way to check this without a GValue
+		return nil
+	}
+
+	zero := InitValue(t).GoValue()
+
+	switch zero {
+	case InvalidValue:
+		return fmt.Errorf("no marshaler registered for %s", t)
+	case nil:
+		if !canBeNil(p) {
+			return fmt.Errorf("%s can not hold %s values, because they can be nil", p, t)
+		}
+
+		if p.Implements(initializerType) && p.Kind() == reflect.Pointer {
+			if d, err := gtypeForGoType(p); err == nil && d != t && !d.IsA(t) {
+				return fmt.Errorf("%s is a %s and can not hold %s values", p, d, t)
+			}
+		}
+
+		return nil
+	}
+
+	zeroType := reflect.TypeOf(zero)
+
+	// go allows converting integers to strings, but the marshaler would create runes
+	if !zeroType.ConvertibleTo(p) || (p.Kind() == reflect.String) != (zeroType.Kind() == reflect.String) {
+		return fmt.Errorf("%s values are marshaled to %s, which can not be converted to %s", t, zeroType, p)
+	}
+
+	return nil
+}
+
+// checkHandlerObjectParam checks that instances of the object or interface GType t can be passed to the
+// go type p. Go types of subclasses are allowed, the actual instance is checked when the signal is emitted.
+func checkHandlerObjectParam(p reflect.Type, t Type) error {
+	if p.Kind() != reflect.Interface && p.Kind() != reflect.Pointer {
+		return fmt.Errorf("%s can not hold %s instances", p, t)
+	}
+
+	if wrapped, ok := objectWrapperType(t); ok && wrapped.ConvertibleTo(p) {
+		return nil
+	}
+
+	d, err := gtypeForGoType(p)
+
+	switch {
+	case err != nil && FundamentalType(t) == TypeInterface && p.Kind() == reflect.Interface:
+		// GInterfaces can be implemented by any class, so we can't know the wrapper
+		return nil
+	case err != nil:
+		return fmt.Errorf("%s can not hold %s instances: %w", p, t, err)
+	case d.IsA(t):
+		// p wraps a subclass of t
+		return nil
+	}
+
+	return fmt.Errorf("%s wraps %s, which is not a %s", p, d, t)
+}
+
+// checkHandlerReturn checks that the go type r can be set to a GValue of type t.
+func checkHandlerReturn(r reflect.Type, t Type) error {
+	if r.Kind() == reflect.Interface && r.NumMethod() == 0 {
+		return nil
+	}
+
+	d, err := gtypeForGoType(r)
+	if err != nil {
+		return err
+	}
+
+	if d == t || d.IsA(t) {
+		return nil
+	}
+
+	if r.Kind() == reflect.Interface && t.IsA(d) {
+		// the returned object may be an instance of t
+		return nil
+	}
+
+	return fmt.Errorf("%s values are %s and can not be returned as %s", r, d, t)
+}
+
+// objectWrapperType returns the go type that instances of t are wrapped with. Subclasses without
+// registered bindings are wrapped with the go type of their closest parent.
+func objectWrapperType(t Type) (reflect.Type, bool) {
+	objectCastingsLock.RLock()
+	defer objectCastingsLock.RUnlock()
+
+	for ; t != 0; t = t.Parent() {
+		if cast, ok := objectCastings[t]; ok {
+			return reflect.TypeOf(cast(&ObjectInstance{})), true
+		}
+	}
+
+	return nil, false
+}
+
+// canBeNil returns true if the values of the go type t can be nil.
+func canBeNil(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return true
+	}
+
+	return false
+}
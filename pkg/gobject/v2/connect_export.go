@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"unsafe"
 
+	"github.com/go-gst/go-glib/pkg/core"
 	"github.com/go-gst/go-glib/pkg/core/closure"
 )
 
@@ -59,6 +60,12 @@ func _goglib_goMarshal(
 			return
 		}
 
+		if val == nil {
+			// nil objects and pointers can not be converted, pass the zero value instead
+			args = append(args, reflect.Zero(fType.In(i)))
+			continue
+		}
+
 		rv := reflect.ValueOf(val)
 		args = append(args, rv.Convert(fType.In(i)))
 	}
@@ -81,5 +88,7 @@ func _goglib_goMarshal(
 
 //export _goglib_removeClosure
 func _goglib_removeClosure(_ *C.GObject, gclosure *C.GClosure) {
+	defer core.RecoverCallback("_goglib_removeClosure")
+
 	closure.Delete(unsafe.Pointer(gclosure))
 }
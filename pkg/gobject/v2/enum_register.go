@@ -0,0 +1,190 @@
+package gobject
+
+import (
+	"cmp"
+	"log"
+	"reflect"
+	"slices"
+	"unsafe"
+)
+
+// #include <stdlib.h>
+// #include <glib-object.h>
+import "C"
+
+// EnumValue describes a single value of an enum or flags type that is registered with
+// [RegisterEnum] or [RegisterFlags].
+type EnumValue struct {
+	// Name is the name of the value, e.g. "MY_MODE_FAST"
+	Name string
+	// Nick is the short name of the value, e.g. "fast". It is shown by gst-inspect and can be used
+	// to set the property from strings.
+	Nick string
+}
+
+// RegisterEnum registers the go type T as a new enum GType with the given name and values. This wraps
+// g_enum_register_static.
+//
+// After registration, values of T can be used with [NewValue], as signal arguments and as the type of
+// properties, like the generated enums. Value.GoValue returns values of the enum type as T. T doesn't
+// need to implement [GoValueInitializer], the bindings know the GType of T.
+//
+// Registering the same name or go type twice panics.
+func RegisterEnum[T ~int](name string, values map[T]EnumValue) Type {
+	entries := sortedEnumValues(values)
+
+	cvalues := (*C.GEnumValue)(C.calloc(C.size_t(len(entries)+1), C.sizeof_GEnumValue))
+	cslice := unsafe.Slice(cvalues, len(entries)+1)
+
+	// the values must stay valid for the lifetime of the program, so they are never freed.
+	// The last value is zeroed and terminates the array.
+	for i, e := range entries {
+		cslice[i].value = C.gint(e.value)
+		cslice[i].value_name = (*C.gchar)(C.CString(e.Name))
+		cslice[i].value_nick = (*C.gchar)(C.CString(e.Nick))
+	}
+
+	gtype := registerEnum(name, cvalues)
+
+	if gtype == TypeInvalid {
+		log.Panicf("enum %s could not be registered", name)
+	}
+
+	RegisterGValueMarshaler(gtype, func(p unsafe.Pointer) (any, error) {
+		return T(ValueFromNative(p).Enum()), nil
+	})
+
+	registerGoType(reflect.TypeFor[T](), registeredGoType{
+		gtype: gtype,
+		set: func(v *Value, goValue any) {
+			v.SetEnum(int(goValue.(T)))
+		},
+	})
+
+	return gtype
+}
+
+// RegisterFlags registers the go type T as a new flags GType with the given name and values. This wraps
+// g_flags_register_static. Every value should be a single bit.
+//
+// After registration, values of T can be used like the generated bitfields, see [RegisterEnum].
+//
+// Registering the same name or go type twice panics.
+func RegisterFlags[T ~uint](name string, values map[T]EnumValue) Type {
+	entries := sortedEnumValues(values)
+
+	cvalues := (*C.GFlagsValue)(C.calloc(C.size_t(len(entries)+1), C.sizeof_GFlagsValue))
+	cslice := unsafe.Slice(cvalues, len(entries)+1)
+
+	// the values must stay valid for the lifetime of the program, so they are never freed.
+	// The last value is zeroed and terminates the array.
+	for i, e := range entries {
+		cslice[i].value = C.guint(e.value)
+		cslice[i].value_name = (*C.gchar)(C.CString(e.Name))
+		cslice[i].value_nick = (*C.gchar)(C.CString(e.Nick))
+	}
+
+	gtype := registerFlags(name, cvalues)
+
+	if gtype == TypeInvalid {
+		log.Panicf("flags %s could not be registered", name)
+	}
+
+	RegisterGValueMarshaler(gtype, func(p unsafe.Pointer) (any, error) {
+		return T(ValueFromNative(p).Flags()), nil
+	})
+
+	registerGoType(reflect.TypeFor[T](), registeredGoType{
+		gtype: gtype,
+		set: func(v *Value, goValue any) {
+			v.SetFlags(int(goValue.(T)))
+		},
+	})
+
+	return gtype
+}
+
+type enumEntry[T ~int | ~uint] struct {
+	EnumValue
+	value T
+}
+
+// sortedEnumValues returns the values sorted by value, so the registered order does not depend on
+// the map iteration order.
+func sortedEnumValues[T ~int | ~uint](values map[T]EnumValue) []enumEntry[T] {
+	entries := make([]enumEntry[T], 0, len(values))
+
+	for v, ev := range values {
+		if ev.Name == "" || ev.Nick == "" {
+			log.Panicf("enum value %d needs a name and a nick", v)
+		}
+
+		entries = append(entries, enumEntry[T]{EnumValue: ev, value: v})
+	}
+
+	slices.SortFunc(entries, func(a, b enumEntry[T]) int {
+		return cmp.Compare(a.value, b.value)
+	})
+
+	return entries
+}
+
+// EnumMember is a value of an enum class, see [EnumMembers].
+type EnumMember struct {
+	Value int
+	Name  string
+	Nick  string
+}
+
+// FlagsMember is a value of a flags class, see [FlagsMembers].
+type FlagsMember struct {
+	Value uint
+	Name  string
+	Nick  string
+}
+
+// EnumMembers returns the values of the enum type t, in the order of the enum class. It panics if t is
+// not an enum type.
+func EnumMembers(t Type) []EnumMember {
+	if FundamentalType(t) != TypeEnum {
+		log.Panicf("%s is not an enum type", t)
+	}
+
+	class := (*C.GEnumClass)(C.g_type_class_ref(C.GType(t)))
+	defer C.g_type_class_unref(C.gpointer(class))
+
+	members := make([]EnumMember, 0, class.n_values)
+
+	for _, v := range unsafe.Slice(class.values, class.n_values) {
+		members = append(members, EnumMember{
+			Value: int(v.value),
+			Name:  C.GoString((*C.char)(v.value_name)),
+			Nick:  C.GoString((*C.char)(v.value_nick)),
+		})
+	}
+
+	return members
+}
+
+// FlagsMembers returns the values of the flags type t, in the order of the flags class. It panics if t is
+// not a flags type.
+func FlagsMembers(t Type) []FlagsMember {
+	if FundamentalType(t) != TypeBitflags {
+		log.Panicf("%s is not a flags type", t)
+	}
+
+	class := (*C.GFlagsClass)(C.g_type_class_ref(C.GType(t)))
+	defer C.g_type_class_unref(C.gpointer(class))
+
+	members := make([]FlagsMember, 0, class.n_values)
+
+	for _, v := range unsafe.Slice(class.values, class.n_values) {
+		members = append(members, FlagsMember{
+			Value: uint(v.value),
+			Name:  C.GoString((*C.char)(v.value_name)),
+			Nick:  C.GoString((*C.char)(v.value_nick)),
+		})
+	}
+
+	return members
+}
@@ -8,28 +8,45 @@ import (
 	"strings"
 	"unsafe"
 
+	"github.com/go-gst/go-glib/pkg/core/classdata"
 	"github.com/go-gst/go-glib/pkg/glib/v2"
 )
 
 // #cgo pkg-config: gobject-2.0
 // #cgo CFLAGS: -Wno-deprecated-declarations
 // #include <glib-object.h>
+// extern gboolean _goglib_gobject2_TypeModule_load(GTypeModule*);
+// extern void _goglib_gobject2_TypeModule_unload(GTypeModule*);
+// gboolean _goglib_gobject2_TypeModule_virtual_load(void* fnptr, GTypeModule* carg0) {
+// 	return ((gboolean (*) (GTypeModule*))(fnptr))(carg0);
+// }
+// void _goglib_gobject2_TypeModule_virtual_unload(void* fnptr, GTypeModule* carg0) {
+// 	return ((void (*) (GTypeModule*))(fnptr))(carg0);
+// }
 import "C"
 
 // GType values.
 var (
 	TypeBindingFlags     = Type(C.g_binding_flags_get_type())
 	TypeIOCondition      = Type(C.g_io_condition_get_type())
+	TypeTypePlugin       = Type(C.g_type_plugin_get_type())
+	TypeBinding          = Type(C.g_binding_get_type())
 	TypeBindingGroup     = Type(C.g_binding_group_get_type())
 	TypeInitiallyUnowned = Type(C.g_initially_unowned_get_type())
+	TypeSignalGroup      = Type(C.g_signal_group_get_type())
+	TypeTypeModule       = Type(C.g_type_module_get_type())
 )
 
 func init() {
 	RegisterGValueMarshalers([]TypeMarshaler{
 		TypeMarshaler{T: TypeBindingFlags, F: marshalBindingFlags},
 		TypeMarshaler{T: TypeIOCondition, F: marshalIOCondition},
+		TypeMarshaler{T: TypeTypePlugin, F: marshalTypePluginInstance},
+		TypeMarshaler{T: TypeBinding, F: marshalBindingInstance},
 		TypeMarshaler{T: TypeBindingGroup, F: marshalBindingGroupInstance},
 		TypeMarshaler{T: TypeInitiallyUnowned, F: marshalInitiallyUnownedInstance},
+		TypeMarshaler{T: TypeSignalGroup, F: marshalSignalGroupInstance},
+		TypeMarshaler{T: TypeTypeModule, F: marshalTypeModuleInstance},
 	})
 }
 
@@ -1784,6 +1801,49 @@ func ParamSpecUnichar(name string, nick string, blurb string, defaultValue uint3
 	return goret
 }
 
+// ParamSpecVariant wraps g_param_spec_variant
+// 
+// see also https://docs.gtk.org/gobject/func.g_param_spec_variant.html
+func ParamSpecVariant(name string, nick string, blurb string, typ *glib.VariantType, defaultValue *glib.Variant, flags ParamFlags) *ParamSpec {
+	var carg1 *C.gchar        // in, none, string
+	var carg2 *C.gchar        // in, none, string, nullable-string
+	var carg3 *C.gchar        // in, none, string, nullable-string
+	var carg4 *C.GVariantType // in, none, converted
+	var carg5 *C.GVariant     // in, none, converted, nullable
+	var carg6 C.GParamFlags   // in, none, casted
+	var cret  *C.GParamSpec   // return, full, converted
+
+	carg1 = (*C.gchar)(unsafe.Pointer(C.CString(name)))
+	defer C.free(unsafe.Pointer(carg1))
+	if nick != "" {
+		carg2 = (*C.gchar)(unsafe.Pointer(C.CString(nick)))
+		defer C.free(unsafe.Pointer(carg2))
+	}
+	if blurb != "" {
+		carg3 = (*C.gchar)(unsafe.Pointer(C.CString(blurb)))
+		defer C.free(unsafe.Pointer(carg3))
+	}
+	carg4 = (*C.GVariantType)(glib.UnsafeVariantTypeToGlibNone(typ))
+	if defaultValue != nil {
+		carg5 = (*C.GVariant)(glib.UnsafeVariantToGlibNone(defaultValue))
+	}
+	carg6 = C.GParamFlags(flags)
+
+	cret = C.g_param_spec_variant(carg1, carg2, carg3, carg4, carg5, carg6)
+	runtime.KeepAlive(name)
+	runtime.KeepAlive(nick)
+	runtime.KeepAlive(blurb)
+	runtime.KeepAlive(typ)
+	runtime.KeepAlive(defaultValue)
+	runtime.KeepAlive(flags)
+
+	var goret *ParamSpec
+
+	goret = UnsafeParamSpecFromGlibFull(unsafe.Pointer(cret))
+
+	return goret
+}
+
 // ParamValueConvert wraps g_param_value_convert
 // 
 // see also https://docs.gtk.org/gobject/func.g_param_value_convert.html
@@ -2126,6 +2186,24 @@ func TypeAddInstancePrivate(classType Type, privateSize uint) int32 {
 	return goret
 }
 
+// TypeAddInterfaceDynamic wraps g_type_add_interface_dynamic
+// 
+// see also https://docs.gtk.org/gobject/func.g_type_add_interface_dynamic.html
+func TypeAddInterfaceDynamic(instanceType Type, interfaceType Type, plugin TypePlugin) {
+	var carg1 C.GType        // in, none, casted, alias
+	var carg2 C.GType        // in, none, casted, alias
+	var carg3 *C.GTypePlugin // in, none, converted
+
+	carg1 = C.GType(instanceType)
+	carg2 = C.GType(interfaceType)
+	carg3 = (*C.GTypePlugin)(UnsafeTypePluginToGlibNone(plugin))
+
+	C.g_type_add_interface_dynamic(carg1, carg2, carg3)
+	runtime.KeepAlive(instanceType)
+	runtime.KeepAlive(interfaceType)
+	runtime.KeepAlive(plugin)
+}
+
 // TypeAddInterfaceStatic wraps g_type_add_interface_static
 // 
 // see also https://docs.gtk.org/gobject/func.g_type_add_interface_static.html
@@ -2374,6 +2452,25 @@ func TypeGetInstanceCount(typ Type) int32 {
 	return goret
 }
 
+// TypeGetPlugin wraps g_type_get_plugin
+// 
+// see also https://docs.gtk.org/gobject/func.g_type_get_plugin.html
+func TypeGetPlugin(typ Type) TypePlugin {
+	var carg1 C.GType        // in, none, casted, alias
+	var cret  *C.GTypePlugin // return, none, converted
+
+	carg1 = C.GType(typ)
+
+	cret = C.g_type_get_plugin(carg1)
+	runtime.KeepAlive(typ)
+
+	var goret TypePlugin
+
+	goret = UnsafeTypePluginFromGlibNone(unsafe.Pointer(cret))
+
+	return goret
+}
+
 // TypeGetTypeRegistrationSerial wraps g_type_get_type_registration_serial
 // 
 // see also https://docs.gtk.org/gobject/func.g_type_get_type_registration_serial.html
@@ -2537,6 +2634,35 @@ func TypeQname(typ Type) glib.Quark {
 	return goret
 }
 
+// TypeRegisterDynamic wraps g_type_register_dynamic
+// 
+// see also https://docs.gtk.org/gobject/func.g_type_register_dynamic.html
+func TypeRegisterDynamic(parentType Type, typeName string, plugin TypePlugin, flags TypeFlags) Type {
+	var carg1 C.GType        // in, none, casted, alias
+	var carg2 *C.gchar       // in, none, string
+	var carg3 *C.GTypePlugin // in, none, converted
+	var carg4 C.GTypeFlags   // in, none, casted
+	var cret  C.GType        // return, none, casted, alias
+
+	carg1 = C.GType(parentType)
+	carg2 = (*C.gchar)(unsafe.Pointer(C.CString(typeName)))
+	defer C.free(unsafe.Pointer(carg2))
+	carg3 = (*C.GTypePlugin)(UnsafeTypePluginToGlibNone(plugin))
+	carg4 = C.GTypeFlags(flags)
+
+	cret = C.g_type_register_dynamic(carg1, carg2, carg3, carg4)
+	runtime.KeepAlive(parentType)
+	runtime.KeepAlive(typeName)
+	runtime.KeepAlive(plugin)
+	runtime.KeepAlive(flags)
+
+	var goret Type
+
+	goret = Type(cret)
+
+	return goret
+}
+
 // TypeTestFlags wraps g_type_test_flags
 // 
 // see also https://docs.gtk.org/gobject/func.g_type_test_flags.html
@@ -2576,172 +2702,1072 @@ func VariantGetGType() Type {
 	return goret
 }
 
+// TypePluginInstance is the instance type used by all types implementing GTypePlugin. It is used internally by the bindings. Users should use the interface [TypePlugin] instead.
+type TypePluginInstance struct {
+	_ [0]func() // equal guard
+	Instance ObjectInstance
+}
+
+var _ TypePlugin = (*TypePluginInstance)(nil)
+
+// TypePlugin wraps GTypePlugin
+// 
+// see also https://docs.gtk.org/gobject/interface.TypePlugin.html
+type TypePlugin interface {
+	upcastToGTypePlugin() *TypePluginInstance
+
+	// CompleteInterfaceInfo wraps g_type_plugin_complete_interface_info
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_type_plugin_complete_interface_info.g_type_plugin_complete_interface_info.html
+	CompleteInterfaceInfo(Type, Type, *InterfaceInfo)
+	// CompleteTypeInfo wraps g_type_plugin_complete_type_info
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_type_plugin_complete_type_info.g_type_plugin_complete_type_info.html
+	CompleteTypeInfo(Type, *TypeInfo, *TypeValueTable)
+	// UnusePlugin wraps g_type_plugin_unuse
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_type_plugin_unuse.g_type_plugin_unuse.html
+	UnusePlugin()
+	// UsePlugin wraps g_type_plugin_use
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_type_plugin_use.g_type_plugin_use.html
+	UsePlugin()
+}
+
+var _ TypePlugin = (*TypePluginInstance)(nil)
+
+func unsafeWrapTypePlugin(base *ObjectInstance) *TypePluginInstance {
+	return &TypePluginInstance{
+		Instance: *base,
+	}
+}
+
+func marshalTypePluginInstance(p unsafe.Pointer) (any, error) {
+	return ValueFromNative(p).Object(), nil
+}
+
+func (t *TypePluginInstance) upcastToGTypePlugin() *TypePluginInstance {
+	return t
+}
+
+// UnsafeTypePluginFromGlibNone is used to convert raw GTypePlugin pointers to go while taking a reference and attaching a finalizer. This is used by the bindings internally.
+func UnsafeTypePluginFromGlibNone(c unsafe.Pointer) TypePlugin {
+	return UnsafeObjectFromGlibNone(c).(TypePlugin)
+}
+
+// UnsafeTypePluginFromGlibFull is used to convert raw GTypePlugin pointers to go while attaching a finalizer. This is used by the bindings internally.
+func UnsafeTypePluginFromGlibFull(c unsafe.Pointer) TypePlugin {
+	return UnsafeObjectFromGlibFull(c).(TypePlugin)
+}
+
+// UnsafeTypePluginFromGlibBorrow is used to convert raw GTypePlugin pointers to go without touching any references. This is used by the bindings internally.
+func UnsafeTypePluginFromGlibBorrow(c unsafe.Pointer) TypePlugin {
+	return UnsafeObjectFromGlibBorrow(c).(TypePlugin)
+}
+
+// UnsafeTypePluginToGlibNone is used to convert the instance to it's C value GTypePlugin. This is used by the bindings internally.
+func UnsafeTypePluginToGlibNone(c TypePlugin) unsafe.Pointer {
+	i := c.upcastToGTypePlugin()
+	return UnsafeObjectToGlibNone(&i.Instance)
+}
+
+// UnsafeTypePluginToGlibFull is used to convert the instance to it's C value GTypePlugin, while removeing the finalizer. This is used by the bindings internally.
+func UnsafeTypePluginToGlibFull(c TypePlugin) unsafe.Pointer {
+	i := c.upcastToGTypePlugin()
+	return UnsafeObjectToGlibFull(&i.Instance)
+}
+
+// CompleteInterfaceInfo wraps g_type_plugin_complete_interface_info
+// 
+// see also https://docs.gtk.org/gobject/method.g_type_plugin_complete_interface_info.g_type_plugin_complete_interface_info.html
+func (plugin *TypePluginInstance) CompleteInterfaceInfo(instanceType Type, interfaceType Type, info *InterfaceInfo) {
+	var carg0 *C.GTypePlugin    // in, none, converted
+	var carg1 C.GType           // in, none, casted, alias
+	var carg2 C.GType           // in, none, casted, alias
+	var carg3 *C.GInterfaceInfo // in, none, converted
+
+	carg0 = (*C.GTypePlugin)(UnsafeTypePluginToGlibNone(plugin))
+	carg1 = C.GType(instanceType)
+	carg2 = C.GType(interfaceType)
+	carg3 = (*C.GInterfaceInfo)(UnsafeInterfaceInfoToGlibNone(info))
+
+	C.g_type_plugin_complete_interface_info(carg0, carg1, carg2, carg3)
+	runtime.KeepAlive(plugin)
+	runtime.KeepAlive(instanceType)
+	runtime.KeepAlive(interfaceType)
+	runtime.KeepAlive(info)
+}
+
+// CompleteTypeInfo wraps g_type_plugin_complete_type_info
+// 
+// see also https://docs.gtk.org/gobject/method.g_type_plugin_complete_type_info.g_type_plugin_complete_type_info.html
+func (plugin *TypePluginInstance) CompleteTypeInfo(gType Type, info *TypeInfo, valueTable *TypeValueTable) {
+	var carg0 *C.GTypePlugin     // in, none, converted
+	var carg1 C.GType            // in, none, casted, alias
+	var carg2 *C.GTypeInfo       // in, none, converted
+	var carg3 *C.GTypeValueTable // in, none, converted
+
+	carg0 = (*C.GTypePlugin)(UnsafeTypePluginToGlibNone(plugin))
+	carg1 = C.GType(gType)
+	carg2 = (*C.GTypeInfo)(UnsafeTypeInfoToGlibNone(info))
+	carg3 = (*C.GTypeValueTable)(UnsafeTypeValueTableToGlibNone(valueTable))
+
+	C.g_type_plugin_complete_type_info(carg0, carg1, carg2, carg3)
+	runtime.KeepAlive(plugin)
+	runtime.KeepAlive(gType)
+	runtime.KeepAlive(info)
+	runtime.KeepAlive(valueTable)
+}
+
+// UnusePlugin wraps g_type_plugin_unuse
+// 
+// see also https://docs.gtk.org/gobject/method.g_type_plugin_unuse.g_type_plugin_unuse.html
+func (plugin *TypePluginInstance) UnusePlugin() {
+	var carg0 *C.GTypePlugin // in, none, converted
+
+	carg0 = (*C.GTypePlugin)(UnsafeTypePluginToGlibNone(plugin))
+
+	C.g_type_plugin_unuse(carg0)
+	runtime.KeepAlive(plugin)
+}
+
+// UsePlugin wraps g_type_plugin_use
+// 
+// see also https://docs.gtk.org/gobject/method.g_type_plugin_use.g_type_plugin_use.html
+func (plugin *TypePluginInstance) UsePlugin() {
+	var carg0 *C.GTypePlugin // in, none, converted
+
+	carg0 = (*C.GTypePlugin)(UnsafeTypePluginToGlibNone(plugin))
+
+	C.g_type_plugin_use(carg0)
+	runtime.KeepAlive(plugin)
+}
+
+// BindingInstance is the instance type used by all types extending GBinding. It is used internally by the bindings. Users should use the interface [Binding] instead.
+type BindingInstance struct {
+	_ [0]func() // equal guard
+	ObjectInstance
+}
+
+var _ Binding = (*BindingInstance)(nil)
+
+// Binding wraps GBinding
+// 
+// see also https://docs.gtk.org/gobject/class.Binding.html
+type Binding interface {
+	Object
+	upcastToGBinding() *BindingInstance
+
+	// DupSource wraps g_binding_dup_source
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_binding_dup_source.g_binding_dup_source.html
+	DupSource() Object
+	// DupTarget wraps g_binding_dup_target
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_binding_dup_target.g_binding_dup_target.html
+	DupTarget() Object
+	// GetFlags wraps g_binding_get_flags
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_binding_get_flags.g_binding_get_flags.html
+	GetFlags() BindingFlags
+	// GetSourceProperty wraps g_binding_get_source_property
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_binding_get_source_property.g_binding_get_source_property.html
+	GetSourceProperty() string
+	// GetTargetProperty wraps g_binding_get_target_property
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_binding_get_target_property.g_binding_get_target_property.html
+	GetTargetProperty() string
+	// Unbind wraps g_binding_unbind
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_binding_unbind.g_binding_unbind.html
+	Unbind()
+	// GetSource gets the "source" property
+	// 
+	// see also https://docs.gtk.org/gobject/property.Binding:source.html
+	GetSource() Object
+	// GetTarget gets the "target" property
+	// 
+	// see also https://docs.gtk.org/gobject/property.Binding:target.html
+	GetTarget() Object
+}
+
+func unsafeWrapBinding(base *ObjectInstance) *BindingInstance {
+	return &BindingInstance{
+		ObjectInstance: *base,
+	}
+}
+
+func init() {
+	RegisterObjectCasting(
+		TypeBinding,
+		func (inst *ObjectInstance) Object {
+			return unsafeWrapBinding(inst)
+		},
+	)
+}
+
+func marshalBindingInstance(p unsafe.Pointer) (any, error) {
+	return ValueFromNative(p).Object(), nil
+}
+
+// UnsafeBindingFromGlibNone is used to convert raw GBinding pointers to go while taking a reference and attaching a finalizer. This is used by the bindings internally.
+func UnsafeBindingFromGlibNone(c unsafe.Pointer) Binding {
+	return UnsafeObjectFromGlibNone(c).(Binding)
+}
+
+// UnsafeBindingFromGlibFull is used to convert raw GBinding pointers to go while attaching a finalizer. This is used by the bindings internally.
+func UnsafeBindingFromGlibFull(c unsafe.Pointer) Binding {
+	return UnsafeObjectFromGlibFull(c).(Binding)
+}
+
+// UnsafeBindingFromGlibBorrow is used to convert raw GBinding pointers to go without touching any references. This is used by the bindings internally.
+func UnsafeBindingFromGlibBorrow(c unsafe.Pointer) Binding {
+	return UnsafeObjectFromGlibBorrow(c).(Binding)
+}
+
+func (b *BindingInstance) upcastToGBinding() *BindingInstance {
+	return b
+}
+
+// UnsafeBindingToGlibNone is used to convert the instance to it's C value GBinding. This is used by the bindings internally.
+func UnsafeBindingToGlibNone(c Binding) unsafe.Pointer {
+	return UnsafeObjectToGlibNone(c)
+}
+
+// UnsafeBindingToGlibFull is used to convert the instance to it's C value GBinding, while removeing the finalizer. This is used by the bindings internally.
+func UnsafeBindingToGlibFull(c Binding) unsafe.Pointer {
+	return UnsafeObjectToGlibFull(c)
+}
+
+// DupSource wraps g_binding_dup_source
+// 
+// see also https://docs.gtk.org/gobject/method.g_binding_dup_source.g_binding_dup_source.html
+func (binding *BindingInstance) DupSource() Object {
+	var carg0 *C.GBinding // in, none, converted
+	var cret  *C.GObject  // return, full, converted, nullable
+
+	carg0 = (*C.GBinding)(UnsafeBindingToGlibNone(binding))
+
+	cret = C.g_binding_dup_source(carg0)
+	runtime.KeepAlive(binding)
+
+	var goret Object
+
+	if cret != nil {
+		goret = UnsafeObjectFromGlibFull(unsafe.Pointer(cret))
+	}
+
+	return goret
+}
+
+// DupTarget wraps g_binding_dup_target
+// 
+// see also https://docs.gtk.org/gobject/method.g_binding_dup_target.g_binding_dup_target.html
+func (binding *BindingInstance) DupTarget() Object {
+	var carg0 *C.GBinding // in, none, converted
+	var cret  *C.GObject  // return, full, converted, nullable
+
+	carg0 = (*C.GBinding)(UnsafeBindingToGlibNone(binding))
+
+	cret = C.g_binding_dup_target(carg0)
+	runtime.KeepAlive(binding)
+
+	var goret Object
+
+	if cret != nil {
+		goret = UnsafeObjectFromGlibFull(unsafe.Pointer(cret))
+	}
+
+	return goret
+}
+
+// GetFlags wraps g_binding_get_flags
+// 
+// see also https://docs.gtk.org/gobject/method.g_binding_get_flags.g_binding_get_flags.html
+func (binding *BindingInstance) GetFlags() BindingFlags {
+	var carg0 *C.GBinding     // in, none, converted
+	var cret  C.GBindingFlags // return, none, casted
+
+	carg0 = (*C.GBinding)(UnsafeBindingToGlibNone(binding))
+
+	cret = C.g_binding_get_flags(carg0)
+	runtime.KeepAlive(binding)
+
+	var goret BindingFlags
+
+	goret = BindingFlags(cret)
+
+	return goret
+}
+
+// GetSourceProperty wraps g_binding_get_source_property
+// 
+// see also https://docs.gtk.org/gobject/method.g_binding_get_source_property.g_binding_get_source_property.html
+func (binding *BindingInstance) GetSourceProperty() string {
+	var carg0 *C.GBinding // in, none, converted
+	var cret  *C.gchar    // return, none, string
+
+	carg0 = (*C.GBinding)(UnsafeBindingToGlibNone(binding))
+
+	cret = C.g_binding_get_source_property(carg0)
+	runtime.KeepAlive(binding)
+
+	var goret string
+
+	goret = C.GoString((*C.char)(unsafe.Pointer(cret)))
+
+	return goret
+}
+
+// GetTargetProperty wraps g_binding_get_target_property
+// 
+// see also https://docs.gtk.org/gobject/method.g_binding_get_target_property.g_binding_get_target_property.html
+func (binding *BindingInstance) GetTargetProperty() string {
+	var carg0 *C.GBinding // in, none, converted
+	var cret  *C.gchar    // return, none, string
+
+	carg0 = (*C.GBinding)(UnsafeBindingToGlibNone(binding))
+
+	cret = C.g_binding_get_target_property(carg0)
+	runtime.KeepAlive(binding)
+
+	var goret string
+
+	goret = C.GoString((*C.char)(unsafe.Pointer(cret)))
+
+	return goret
+}
+
+// Unbind wraps g_binding_unbind
+// 
+// see also https://docs.gtk.org/gobject/method.g_binding_unbind.g_binding_unbind.html
+func (binding *BindingInstance) Unbind() {
+	var carg0 *C.GBinding // in, none, converted
+
+	carg0 = (*C.GBinding)(UnsafeBindingToGlibNone(binding))
+
+	C.g_binding_unbind(carg0)
+	runtime.KeepAlive(binding)
+}
+
+// GetSource gets the "source" property
+// 
+// see also https://docs.gtk.org/gobject/property.Binding:source.html
+func (o *BindingInstance) GetSource() Object {
+	return ObjectPropertyAs[Object](o, "source")
+}
+
+// GetTarget gets the "target" property
+// 
+// see also https://docs.gtk.org/gobject/property.Binding:target.html
+func (o *BindingInstance) GetTarget() Object {
+	return ObjectPropertyAs[Object](o, "target")
+}
+
 // BindingGroupInstance is the instance type used by all types extending GBindingGroup. It is used internally by the bindings. Users should use the interface [BindingGroup] instead.
 type BindingGroupInstance struct {
 	_ [0]func() // equal guard
 	ObjectInstance
 }
 
-var _ BindingGroup = (*BindingGroupInstance)(nil)
+var _ BindingGroup = (*BindingGroupInstance)(nil)
+
+// BindingGroup wraps GBindingGroup
+// 
+// see also https://docs.gtk.org/gobject/class.BindingGroup.html
+type BindingGroup interface {
+	BindingGroupExtManual // handwritten functions
+	Object
+	upcastToGBindingGroup() *BindingGroupInstance
+
+	// GetSource gets the "source" property
+	// 
+	// see also https://docs.gtk.org/gobject/property.BindingGroup:source.html
+	GetSource() Object
+	// SetSource sets the "source" property
+	// 
+	// see also https://docs.gtk.org/gobject/property.BindingGroup:source.html
+	SetSource(Object)
+	// NotifySource connects the provided callback to change notifications of the "source" property
+	// 
+	// see also https://docs.gtk.org/gobject/property.BindingGroup:source.html
+	NotifySource(func()) SignalHandle
+}
+
+func unsafeWrapBindingGroup(base *ObjectInstance) *BindingGroupInstance {
+	return &BindingGroupInstance{
+		ObjectInstance: *base,
+	}
+}
+
+func init() {
+	RegisterObjectCasting(
+		TypeBindingGroup,
+		func (inst *ObjectInstance) Object {
+			return unsafeWrapBindingGroup(inst)
+		},
+	)
+}
+
+func marshalBindingGroupInstance(p unsafe.Pointer) (any, error) {
+	return ValueFromNative(p).Object(), nil
+}
+
+// UnsafeBindingGroupFromGlibNone is used to convert raw GBindingGroup pointers to go while taking a reference and attaching a finalizer. This is used by the bindings internally.
+func UnsafeBindingGroupFromGlibNone(c unsafe.Pointer) BindingGroup {
+	return UnsafeObjectFromGlibNone(c).(BindingGroup)
+}
+
+// UnsafeBindingGroupFromGlibFull is used to convert raw GBindingGroup pointers to go while attaching a finalizer. This is used by the bindings internally.
+func UnsafeBindingGroupFromGlibFull(c unsafe.Pointer) BindingGroup {
+	return UnsafeObjectFromGlibFull(c).(BindingGroup)
+}
+
+// UnsafeBindingGroupFromGlibBorrow is used to convert raw GBindingGroup pointers to go without touching any references. This is used by the bindings internally.
+func UnsafeBindingGroupFromGlibBorrow(c unsafe.Pointer) BindingGroup {
+	return UnsafeObjectFromGlibBorrow(c).(BindingGroup)
+}
+
+func (b *BindingGroupInstance) upcastToGBindingGroup() *BindingGroupInstance {
+	return b
+}
+
+// UnsafeBindingGroupToGlibNone is used to convert the instance to it's C value GBindingGroup. This is used by the bindings internally.
+func UnsafeBindingGroupToGlibNone(c BindingGroup) unsafe.Pointer {
+	return UnsafeObjectToGlibNone(c)
+}
+
+// UnsafeBindingGroupToGlibFull is used to convert the instance to it's C value GBindingGroup, while removeing the finalizer. This is used by the bindings internally.
+func UnsafeBindingGroupToGlibFull(c BindingGroup) unsafe.Pointer {
+	return UnsafeObjectToGlibFull(c)
+}
+
+// NewBindingGroup wraps g_binding_group_new
+// 
+// see also https://docs.gtk.org/gobject/func.g_binding_group_new.html
+func NewBindingGroup() BindingGroup {
+	var cret *C.GBindingGroup // return, full, converted
+
+	cret = C.g_binding_group_new()
+
+	var goret BindingGroup
+
+	goret = UnsafeBindingGroupFromGlibFull(unsafe.Pointer(cret))
+
+	return goret
+}
+
+// GetSource gets the "source" property
+// 
+// see also https://docs.gtk.org/gobject/property.BindingGroup:source.html
+func (o *BindingGroupInstance) GetSource() Object {
+	return ObjectPropertyAs[Object](o, "source")
+}
+
+// SetSource sets the "source" property
+// 
+// see also https://docs.gtk.org/gobject/property.BindingGroup:source.html
+func (o *BindingGroupInstance) SetSource(value Object) {
+	o.SetObjectProperty("source", value)
+}
+
+// NotifySource connects the provided callback to change notifications of the "source" property
+// 
+// see also https://docs.gtk.org/gobject/property.BindingGroup:source.html
+func (o *BindingGroupInstance) NotifySource(fn func()) SignalHandle {
+	return o.NotifyProperty("source", func(Object, *ParamSpec) {
+		fn()
+	})
+}
+
+// InitiallyUnownedInstance is the instance type used by all types extending GInitiallyUnowned. It is used internally by the bindings. Users should use the interface [InitiallyUnowned] instead.
+type InitiallyUnownedInstance struct {
+	_ [0]func() // equal guard
+	ObjectInstance
+}
+
+var _ InitiallyUnowned = (*InitiallyUnownedInstance)(nil)
+
+// InitiallyUnowned wraps GInitiallyUnowned
+// 
+// see also https://docs.gtk.org/gobject/class.InitiallyUnowned.html
+type InitiallyUnowned interface {
+	Object
+	upcastToGInitiallyUnowned() *InitiallyUnownedInstance
+
+	// chain up virtual methods:
+}
+
+func unsafeWrapInitiallyUnowned(base *ObjectInstance) *InitiallyUnownedInstance {
+	return &InitiallyUnownedInstance{
+		ObjectInstance: *base,
+	}
+}
+
+func init() {
+	RegisterObjectCasting(
+		TypeInitiallyUnowned,
+		func (inst *ObjectInstance) Object {
+			return unsafeWrapInitiallyUnowned(inst)
+		},
+	)
+}
+
+func marshalInitiallyUnownedInstance(p unsafe.Pointer) (any, error) {
+	return ValueFromNative(p).Object(), nil
+}
+
+// UnsafeInitiallyUnownedFromGlibNone is used to convert raw GInitiallyUnowned pointers to go while taking a reference and attaching a finalizer. This is used by the bindings internally.
+func UnsafeInitiallyUnownedFromGlibNone(c unsafe.Pointer) InitiallyUnowned {
+	return UnsafeObjectFromGlibNone(c).(InitiallyUnowned)
+}
+
+// UnsafeInitiallyUnownedFromGlibFull is used to convert raw GInitiallyUnowned pointers to go while attaching a finalizer. This is used by the bindings internally.
+func UnsafeInitiallyUnownedFromGlibFull(c unsafe.Pointer) InitiallyUnowned {
+	return UnsafeObjectFromGlibFull(c).(InitiallyUnowned)
+}
+
+// UnsafeInitiallyUnownedFromGlibBorrow is used to convert raw GInitiallyUnowned pointers to go without touching any references. This is used by the bindings internally.
+func UnsafeInitiallyUnownedFromGlibBorrow(c unsafe.Pointer) InitiallyUnowned {
+	return UnsafeObjectFromGlibBorrow(c).(InitiallyUnowned)
+}
+
+func (i *InitiallyUnownedInstance) upcastToGInitiallyUnowned() *InitiallyUnownedInstance {
+	return i
+}
+
+// UnsafeInitiallyUnownedToGlibNone is used to convert the instance to it's C value GInitiallyUnowned. This is used by the bindings internally.
+func UnsafeInitiallyUnownedToGlibNone(c InitiallyUnowned) unsafe.Pointer {
+	return UnsafeObjectToGlibNone(c)
+}
+
+// UnsafeInitiallyUnownedToGlibFull is used to convert the instance to it's C value GInitiallyUnowned, while removeing the finalizer. This is used by the bindings internally.
+func UnsafeInitiallyUnownedToGlibFull(c InitiallyUnowned) unsafe.Pointer {
+	return UnsafeObjectToGlibFull(c)
+}
+
+// InitiallyUnownedOverrides is the struct used to override the default implementation of virtual methods.
+// it is generic over the extending instance type.
+type InitiallyUnownedOverrides[Instance InitiallyUnowned] struct {
+	// ObjectOverrides allows you to override virtual methods from the parent class Object
+	ObjectOverrides[Instance]
+
+}
+
+// UnsafeApplyInitiallyUnownedOverrides applies the overrides to init the gclass by setting the trampoline functions.
+// This is used by the bindings internally and only exported for visibility to other bindings code.
+func UnsafeApplyInitiallyUnownedOverrides[Instance InitiallyUnowned](gclass unsafe.Pointer, overrides InitiallyUnownedOverrides[Instance]) {
+	UnsafeApplyObjectOverrides(gclass, overrides.ObjectOverrides)
+}
+
+// RegisterInitiallyUnownedSubClass is used to register a go subclass of GInitiallyUnowned. For this to work safely please implement the
+// virtual methods required by the implementation.
+func RegisterInitiallyUnownedSubClass[InstanceT InitiallyUnowned](
+		name string,
+		classInit func(class *InitiallyUnownedClass),
+		constructor func() InstanceT,
+		overrides InitiallyUnownedOverrides[InstanceT],
+		signals map[string]SignalDefinition,
+		interfaceInits ...SubClassInterfaceInit[InstanceT],
+) Type {
+	return UnsafeRegisterSubClass(
+		name,
+		classInit,
+		constructor,
+		overrides,
+		signals,
+		TypeInitiallyUnowned,
+		UnsafeInitiallyUnownedClassFromGlibBorrow,
+		UnsafeApplyInitiallyUnownedOverrides,
+		func (obj *ObjectInstance) Object {
+			return unsafeWrapInitiallyUnowned(obj)
+		},
+		interfaceInits...,
+	)
+}
+
+// SignalGroupInstance is the instance type used by all types extending GSignalGroup. It is used internally by the bindings. Users should use the interface [SignalGroup] instead.
+type SignalGroupInstance struct {
+	_ [0]func() // equal guard
+	ObjectInstance
+}
+
+var _ SignalGroup = (*SignalGroupInstance)(nil)
+
+// SignalGroup wraps GSignalGroup
+// 
+// see also https://docs.gtk.org/gobject/class.SignalGroup.html
+type SignalGroup interface {
+	SignalGroupExtManual // handwritten functions
+	Object
+	upcastToGSignalGroup() *SignalGroupInstance
+
+	// Block wraps g_signal_group_block
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_signal_group_block.g_signal_group_block.html
+	Block()
+	// Unblock wraps g_signal_group_unblock
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_signal_group_unblock.g_signal_group_unblock.html
+	Unblock()
+	// ConnectBind connects the provided callback to the "bind" signal
+	// 
+	// see also https://docs.gtk.org/gobject/signal.SignalGroup.bind.html
+	ConnectBind(func(SignalGroup, Object)) SignalHandle
+	// ConnectUnbind connects the provided callback to the "unbind" signal
+	// 
+	// see also https://docs.gtk.org/gobject/signal.SignalGroup.unbind.html
+	ConnectUnbind(func(SignalGroup)) SignalHandle
+	// GetTarget gets the "target" property
+	// 
+	// see also https://docs.gtk.org/gobject/property.SignalGroup:target.html
+	GetTarget() Object
+	// SetTarget sets the "target" property
+	// 
+	// see also https://docs.gtk.org/gobject/property.SignalGroup:target.html
+	SetTarget(Object)
+	// NotifyTarget connects the provided callback to change notifications of the "target" property
+	// 
+	// see also https://docs.gtk.org/gobject/property.SignalGroup:target.html
+	NotifyTarget(func()) SignalHandle
+}
+
+func unsafeWrapSignalGroup(base *ObjectInstance) *SignalGroupInstance {
+	return &SignalGroupInstance{
+		ObjectInstance: *base,
+	}
+}
+
+func init() {
+	RegisterObjectCasting(
+		TypeSignalGroup,
+		func (inst *ObjectInstance) Object {
+			return unsafeWrapSignalGroup(inst)
+		},
+	)
+}
+
+func marshalSignalGroupInstance(p unsafe.Pointer) (any, error) {
+	return ValueFromNative(p).Object(), nil
+}
+
+// UnsafeSignalGroupFromGlibNone is used to convert raw GSignalGroup pointers to go while taking a reference and attaching a finalizer. This is used by the bindings internally.
+func UnsafeSignalGroupFromGlibNone(c unsafe.Pointer) SignalGroup {
+	return UnsafeObjectFromGlibNone(c).(SignalGroup)
+}
+
+// UnsafeSignalGroupFromGlibFull is used to convert raw GSignalGroup pointers to go while attaching a finalizer. This is used by the bindings internally.
+func UnsafeSignalGroupFromGlibFull(c unsafe.Pointer) SignalGroup {
+	return UnsafeObjectFromGlibFull(c).(SignalGroup)
+}
+
+// UnsafeSignalGroupFromGlibBorrow is used to convert raw GSignalGroup pointers to go without touching any references. This is used by the bindings internally.
+func UnsafeSignalGroupFromGlibBorrow(c unsafe.Pointer) SignalGroup {
+	return UnsafeObjectFromGlibBorrow(c).(SignalGroup)
+}
+
+func (s *SignalGroupInstance) upcastToGSignalGroup() *SignalGroupInstance {
+	return s
+}
+
+// UnsafeSignalGroupToGlibNone is used to convert the instance to it's C value GSignalGroup. This is used by the bindings internally.
+func UnsafeSignalGroupToGlibNone(c SignalGroup) unsafe.Pointer {
+	return UnsafeObjectToGlibNone(c)
+}
+
+// UnsafeSignalGroupToGlibFull is used to convert the instance to it's C value GSignalGroup, while removeing the finalizer. This is used by the bindings internally.
+func UnsafeSignalGroupToGlibFull(c SignalGroup) unsafe.Pointer {
+	return UnsafeObjectToGlibFull(c)
+}
+
+// NewSignalGroup wraps g_signal_group_new
+// 
+// see also https://docs.gtk.org/gobject/func.g_signal_group_new.html
+func NewSignalGroup(targetType Type) SignalGroup {
+	var carg1 C.GType         // in, none, casted, alias
+	var cret  *C.GSignalGroup // return, full, converted
+
+	carg1 = C.GType(targetType)
+
+	cret = C.g_signal_group_new(carg1)
+	runtime.KeepAlive(targetType)
+
+	var goret SignalGroup
+
+	goret = UnsafeSignalGroupFromGlibFull(unsafe.Pointer(cret))
+
+	return goret
+}
+
+// Block wraps g_signal_group_block
+// 
+// see also https://docs.gtk.org/gobject/method.g_signal_group_block.g_signal_group_block.html
+func (self *SignalGroupInstance) Block() {
+	var carg0 *C.GSignalGroup // in, none, converted
+
+	carg0 = (*C.GSignalGroup)(UnsafeSignalGroupToGlibNone(self))
+
+	C.g_signal_group_block(carg0)
+	runtime.KeepAlive(self)
+}
+
+// Unblock wraps g_signal_group_unblock
+// 
+// see also https://docs.gtk.org/gobject/method.g_signal_group_unblock.g_signal_group_unblock.html
+func (self *SignalGroupInstance) Unblock() {
+	var carg0 *C.GSignalGroup // in, none, converted
+
+	carg0 = (*C.GSignalGroup)(UnsafeSignalGroupToGlibNone(self))
+
+	C.g_signal_group_unblock(carg0)
+	runtime.KeepAlive(self)
+}
+
+// ConnectBind connects the provided callback to the "bind" signal
+// 
+// see also https://docs.gtk.org/gobject/signal.SignalGroup.bind.html
+func (o *SignalGroupInstance) ConnectBind(fn func(SignalGroup, Object)) SignalHandle {
+	return o.Connect("bind", fn)
+}
+
+// ConnectUnbind connects the provided callback to the "unbind" signal
+// 
+// see also https://docs.gtk.org/gobject/signal.SignalGroup.unbind.html
+func (o *SignalGroupInstance) ConnectUnbind(fn func(SignalGroup)) SignalHandle {
+	return o.Connect("unbind", fn)
+}
+
+// GetTarget gets the "target" property
+// 
+// see also https://docs.gtk.org/gobject/property.SignalGroup:target.html
+func (o *SignalGroupInstance) GetTarget() Object {
+	return ObjectPropertyAs[Object](o, "target")
+}
+
+// SetTarget sets the "target" property
+// 
+// see also https://docs.gtk.org/gobject/property.SignalGroup:target.html
+func (o *SignalGroupInstance) SetTarget(value Object) {
+	o.SetObjectProperty("target", value)
+}
+
+// NotifyTarget connects the provided callback to change notifications of the "target" property
+// 
+// see also https://docs.gtk.org/gobject/property.SignalGroup:target.html
+func (o *SignalGroupInstance) NotifyTarget(fn func()) SignalHandle {
+	return o.NotifyProperty("target", func(Object, *ParamSpec) {
+		fn()
+	})
+}
+
+// TypeModuleInstance is the instance type used by all types extending GTypeModule. It is used internally by the bindings. Users should use the interface [TypeModule] instead.
+type TypeModuleInstance struct {
+	_ [0]func() // equal guard
+	ObjectInstance
+}
+
+var _ TypeModule = (*TypeModuleInstance)(nil)
 
-// BindingGroup wraps GBindingGroup
+// TypeModule wraps GTypeModule
 // 
-// see also https://docs.gtk.org/gobject/class.BindingGroup.html
-type BindingGroup interface {
+// see also https://docs.gtk.org/gobject/class.TypeModule.html
+type TypeModule interface {
 	Object
-	upcastToGBindingGroup() *BindingGroupInstance
+	upcastToGTypeModule() *TypeModuleInstance
+
+	// AddInterface wraps g_type_module_add_interface
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_type_module_add_interface.g_type_module_add_interface.html
+	AddInterface(Type, Type, *InterfaceInfo)
+	// RegisterType wraps g_type_module_register_type
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_type_module_register_type.g_type_module_register_type.html
+	RegisterType(Type, string, *TypeInfo, TypeFlags) Type
+	// SetName wraps g_type_module_set_name
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_type_module_set_name.g_type_module_set_name.html
+	SetName(string)
+	// Unuse wraps g_type_module_unuse
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_type_module_unuse.g_type_module_unuse.html
+	Unuse()
+	// Use wraps g_type_module_use
+	// 
+	// see also https://docs.gtk.org/gobject/method.g_type_module_use.g_type_module_use.html
+	Use() bool
+
+	// chain up virtual methods:
+
+	// ParentLoad calls the default implementations of the `GTypeModule.load` virtual method.
+	// This function's behavior is not defined when the parent does not implement the virtual method.
+	// 
+	// see also https://docs.gtk.org/gobject/method.TypeModule.load.html
+	ParentLoad() bool
+	// ParentUnload calls the default implementations of the `GTypeModule.unload` virtual method.
+	// This function's behavior is not defined when the parent does not implement the virtual method.
+	// 
+	// see also https://docs.gtk.org/gobject/method.TypeModule.unload.html
+	ParentUnload()
 }
 
-func unsafeWrapBindingGroup(base *ObjectInstance) *BindingGroupInstance {
-	return &BindingGroupInstance{
+func unsafeWrapTypeModule(base *ObjectInstance) *TypeModuleInstance {
+	return &TypeModuleInstance{
 		ObjectInstance: *base,
 	}
 }
 
 func init() {
 	RegisterObjectCasting(
-		TypeBindingGroup,
+		TypeTypeModule,
 		func (inst *ObjectInstance) Object {
-			return unsafeWrapBindingGroup(inst)
+			return unsafeWrapTypeModule(inst)
 		},
 	)
 }
 
-func marshalBindingGroupInstance(p unsafe.Pointer) (any, error) {
+func marshalTypeModuleInstance(p unsafe.Pointer) (any, error) {
 	return ValueFromNative(p).Object(), nil
 }
 
-// UnsafeBindingGroupFromGlibNone is used to convert raw GBindingGroup pointers to go while taking a reference and attaching a finalizer. This is used by the bindings internally.
-func UnsafeBindingGroupFromGlibNone(c unsafe.Pointer) BindingGroup {
-	return UnsafeObjectFromGlibNone(c).(BindingGroup)
+// UnsafeTypeModuleFromGlibNone is used to convert raw GTypeModule pointers to go while taking a reference and attaching a finalizer. This is used by the bindings internally.
+func UnsafeTypeModuleFromGlibNone(c unsafe.Pointer) TypeModule {
+	return UnsafeObjectFromGlibNone(c).(TypeModule)
 }
 
-// UnsafeBindingGroupFromGlibFull is used to convert raw GBindingGroup pointers to go while attaching a finalizer. This is used by the bindings internally.
-func UnsafeBindingGroupFromGlibFull(c unsafe.Pointer) BindingGroup {
-	return UnsafeObjectFromGlibFull(c).(BindingGroup)
+// UnsafeTypeModuleFromGlibFull is used to convert raw GTypeModule pointers to go while attaching a finalizer. This is used by the bindings internally.
+func UnsafeTypeModuleFromGlibFull(c unsafe.Pointer) TypeModule {
+	return UnsafeObjectFromGlibFull(c).(TypeModule)
 }
 
-// UnsafeBindingGroupFromGlibBorrow is used to convert raw GBindingGroup pointers to go without touching any references. This is used by the bindings internally.
-func UnsafeBindingGroupFromGlibBorrow(c unsafe.Pointer) BindingGroup {
-	return UnsafeObjectFromGlibBorrow(c).(BindingGroup)
+// UnsafeTypeModuleFromGlibBorrow is used to convert raw GTypeModule pointers to go without touching any references. This is used by the bindings internally.
+func UnsafeTypeModuleFromGlibBorrow(c unsafe.Pointer) TypeModule {
+	return UnsafeObjectFromGlibBorrow(c).(TypeModule)
 }
 
-func (b *BindingGroupInstance) upcastToGBindingGroup() *BindingGroupInstance {
-	return b
+func (t *TypeModuleInstance) upcastToGTypeModule() *TypeModuleInstance {
+	return t
 }
 
-// UnsafeBindingGroupToGlibNone is used to convert the instance to it's C value GBindingGroup. This is used by the bindings internally.
-func UnsafeBindingGroupToGlibNone(c BindingGroup) unsafe.Pointer {
+// UnsafeTypeModuleToGlibNone is used to convert the instance to it's C value GTypeModule. This is used by the bindings internally.
+func UnsafeTypeModuleToGlibNone(c TypeModule) unsafe.Pointer {
 	return UnsafeObjectToGlibNone(c)
 }
 
-// UnsafeBindingGroupToGlibFull is used to convert the instance to it's C value GBindingGroup, while removeing the finalizer. This is used by the bindings internally.
-func UnsafeBindingGroupToGlibFull(c BindingGroup) unsafe.Pointer {
+// UnsafeTypeModuleToGlibFull is used to convert the instance to it's C value GTypeModule, while removeing the finalizer. This is used by the bindings internally.
+func UnsafeTypeModuleToGlibFull(c TypeModule) unsafe.Pointer {
 	return UnsafeObjectToGlibFull(c)
 }
 
-// NewBindingGroup wraps g_binding_group_new
+// AddInterface wraps g_type_module_add_interface
 // 
-// see also https://docs.gtk.org/gobject/func.g_binding_group_new.html
-func NewBindingGroup() BindingGroup {
-	var cret *C.GBindingGroup // return, full, converted
+// see also https://docs.gtk.org/gobject/method.g_type_module_add_interface.g_type_module_add_interface.html
+func (module *TypeModuleInstance) AddInterface(instanceType Type, interfaceType Type, interfaceInfo *InterfaceInfo) {
+	var carg0 *C.GTypeModule    // in, none, converted
+	var carg1 C.GType           // in, none, casted, alias
+	var carg2 C.GType           // in, none, casted, alias
+	var carg3 *C.GInterfaceInfo // in, none, converted
 
-	cret = C.g_binding_group_new()
+	carg0 = (*C.GTypeModule)(UnsafeTypeModuleToGlibNone(module))
+	carg1 = C.GType(instanceType)
+	carg2 = C.GType(interfaceType)
+	carg3 = (*C.GInterfaceInfo)(UnsafeInterfaceInfoToGlibNone(interfaceInfo))
 
-	var goret BindingGroup
+	C.g_type_module_add_interface(carg0, carg1, carg2, carg3)
+	runtime.KeepAlive(module)
+	runtime.KeepAlive(instanceType)
+	runtime.KeepAlive(interfaceType)
+	runtime.KeepAlive(interfaceInfo)
+}
 
-	goret = UnsafeBindingGroupFromGlibFull(unsafe.Pointer(cret))
+// RegisterType wraps g_type_module_register_type
+// 
+// see also https://docs.gtk.org/gobject/method.g_type_module_register_type.g_type_module_register_type.html
+func (module *TypeModuleInstance) RegisterType(parentType Type, typeName string, typeInfo *TypeInfo, flags TypeFlags) Type {
+	var carg0 *C.GTypeModule // in, none, converted
+	var carg1 C.GType        // in, none, casted, alias
+	var carg2 *C.gchar       // in, none, string
+	var carg3 *C.GTypeInfo   // in, none, converted
+	var carg4 C.GTypeFlags   // in, none, casted
+	var cret  C.GType        // return, none, casted, alias
 
-	return goret
-}
+	carg0 = (*C.GTypeModule)(UnsafeTypeModuleToGlibNone(module))
+	carg1 = C.GType(parentType)
+	carg2 = (*C.gchar)(unsafe.Pointer(C.CString(typeName)))
+	defer C.free(unsafe.Pointer(carg2))
+	carg3 = (*C.GTypeInfo)(UnsafeTypeInfoToGlibNone(typeInfo))
+	carg4 = C.GTypeFlags(flags)
+
+	cret = C.g_type_module_register_type(carg0, carg1, carg2, carg3, carg4)
+	runtime.KeepAlive(module)
+	runtime.KeepAlive(parentType)
+	runtime.KeepAlive(typeName)
+	runtime.KeepAlive(typeInfo)
+	runtime.KeepAlive(flags)
 
-// InitiallyUnownedInstance is the instance type used by all types extending GInitiallyUnowned. It is used internally by the bindings. Users should use the interface [InitiallyUnowned] instead.
-type InitiallyUnownedInstance struct {
-	_ [0]func() // equal guard
-	ObjectInstance
-}
+	var goret Type
 
-var _ InitiallyUnowned = (*InitiallyUnownedInstance)(nil)
+	goret = Type(cret)
 
-// InitiallyUnowned wraps GInitiallyUnowned
+	return goret
+}
+
+// SetName wraps g_type_module_set_name
 // 
-// see also https://docs.gtk.org/gobject/class.InitiallyUnowned.html
-type InitiallyUnowned interface {
-	Object
-	upcastToGInitiallyUnowned() *InitiallyUnownedInstance
+// see also https://docs.gtk.org/gobject/method.g_type_module_set_name.g_type_module_set_name.html
+func (module *TypeModuleInstance) SetName(name string) {
+	var carg0 *C.GTypeModule // in, none, converted
+	var carg1 *C.gchar       // in, none, string
 
-	// chain up virtual methods:
-}
+	carg0 = (*C.GTypeModule)(UnsafeTypeModuleToGlibNone(module))
+	carg1 = (*C.gchar)(unsafe.Pointer(C.CString(name)))
+	defer C.free(unsafe.Pointer(carg1))
 
-func unsafeWrapInitiallyUnowned(base *ObjectInstance) *InitiallyUnownedInstance {
-	return &InitiallyUnownedInstance{
-		ObjectInstance: *base,
-	}
+	C.g_type_module_set_name(carg0, carg1)
+	runtime.KeepAlive(module)
+	runtime.KeepAlive(name)
 }
 
-func init() {
-	RegisterObjectCasting(
-		TypeInitiallyUnowned,
-		func (inst *ObjectInstance) Object {
-			return unsafeWrapInitiallyUnowned(inst)
-		},
-	)
-}
+// Unuse wraps g_type_module_unuse
+// 
+// see also https://docs.gtk.org/gobject/method.g_type_module_unuse.g_type_module_unuse.html
+func (module *TypeModuleInstance) Unuse() {
+	var carg0 *C.GTypeModule // in, none, converted
 
-func marshalInitiallyUnownedInstance(p unsafe.Pointer) (any, error) {
-	return ValueFromNative(p).Object(), nil
-}
+	carg0 = (*C.GTypeModule)(UnsafeTypeModuleToGlibNone(module))
 
-// UnsafeInitiallyUnownedFromGlibNone is used to convert raw GInitiallyUnowned pointers to go while taking a reference and attaching a finalizer. This is used by the bindings internally.
-func UnsafeInitiallyUnownedFromGlibNone(c unsafe.Pointer) InitiallyUnowned {
-	return UnsafeObjectFromGlibNone(c).(InitiallyUnowned)
+	C.g_type_module_unuse(carg0)
+	runtime.KeepAlive(module)
 }
 
-// UnsafeInitiallyUnownedFromGlibFull is used to convert raw GInitiallyUnowned pointers to go while attaching a finalizer. This is used by the bindings internally.
-func UnsafeInitiallyUnownedFromGlibFull(c unsafe.Pointer) InitiallyUnowned {
-	return UnsafeObjectFromGlibFull(c).(InitiallyUnowned)
-}
+// Use wraps g_type_module_use
+// 
+// see also https://docs.gtk.org/gobject/method.g_type_module_use.g_type_module_use.html
+func (module *TypeModuleInstance) Use() bool {
+	var carg0 *C.GTypeModule // in, none, converted
+	var cret  C.gboolean     // return
 
-// UnsafeInitiallyUnownedFromGlibBorrow is used to convert raw GInitiallyUnowned pointers to go without touching any references. This is used by the bindings internally.
-func UnsafeInitiallyUnownedFromGlibBorrow(c unsafe.Pointer) InitiallyUnowned {
-	return UnsafeObjectFromGlibBorrow(c).(InitiallyUnowned)
-}
+	carg0 = (*C.GTypeModule)(UnsafeTypeModuleToGlibNone(module))
 
-func (i *InitiallyUnownedInstance) upcastToGInitiallyUnowned() *InitiallyUnownedInstance {
-	return i
-}
+	cret = C.g_type_module_use(carg0)
+	runtime.KeepAlive(module)
 
-// UnsafeInitiallyUnownedToGlibNone is used to convert the instance to it's C value GInitiallyUnowned. This is used by the bindings internally.
-func UnsafeInitiallyUnownedToGlibNone(c InitiallyUnowned) unsafe.Pointer {
-	return UnsafeObjectToGlibNone(c)
-}
+	var goret bool
 
-// UnsafeInitiallyUnownedToGlibFull is used to convert the instance to it's C value GInitiallyUnowned, while removeing the finalizer. This is used by the bindings internally.
-func UnsafeInitiallyUnownedToGlibFull(c InitiallyUnowned) unsafe.Pointer {
-	return UnsafeObjectToGlibFull(c)
+	if cret != 0 {
+		goret = true
+	}
+
+	return goret
 }
 
-// InitiallyUnownedOverrides is the struct used to override the default implementation of virtual methods.
+// TypeModuleOverrides is the struct used to override the default implementation of virtual methods.
 // it is generic over the extending instance type.
-type InitiallyUnownedOverrides[Instance InitiallyUnowned] struct {
+type TypeModuleOverrides[Instance TypeModule] struct {
 	// ObjectOverrides allows you to override virtual methods from the parent class Object
 	ObjectOverrides[Instance]
 
+	// // Load allows you to override the implementation of the virtual method load.
+	// 
+	// see also https://docs.gtk.org/gobject/method.TypeModule.load.html
+	Load func(Instance) bool
+	// // Unload allows you to override the implementation of the virtual method unload.
+	// 
+	// see also https://docs.gtk.org/gobject/method.TypeModule.unload.html
+	Unload func(Instance)
 }
 
-// UnsafeApplyInitiallyUnownedOverrides applies the overrides to init the gclass by setting the trampoline functions.
+// UnsafeApplyTypeModuleOverrides applies the overrides to init the gclass by setting the trampoline functions.
 // This is used by the bindings internally and only exported for visibility to other bindings code.
-func UnsafeApplyInitiallyUnownedOverrides[Instance InitiallyUnowned](gclass unsafe.Pointer, overrides InitiallyUnownedOverrides[Instance]) {
+func UnsafeApplyTypeModuleOverrides[Instance TypeModule](gclass unsafe.Pointer, overrides TypeModuleOverrides[Instance]) {
 	UnsafeApplyObjectOverrides(gclass, overrides.ObjectOverrides)
+
+	pclass := (*C.GTypeModuleClass)(gclass)
+
+	if overrides.Load != nil {
+		pclass.load = (*[0]byte)(C._goglib_gobject2_TypeModule_load)
+		classdata.StoreVirtualMethod(
+			unsafe.Pointer(pclass),
+			"_goglib_gobject2_TypeModule_load",
+			func(carg0 *C.GTypeModule) (cret C.gboolean) {
+				var module Instance // go GTypeModule subclass
+				var goret  bool     // return
+
+				module = UnsafeTypeModuleFromGlibBorrow(unsafe.Pointer(carg0)).UnsafeLoadInstanceFromPrivateData().(Instance)
+
+				goret = overrides.Load(module)
+
+				if goret {
+					cret = C.TRUE
+				}
+
+				return cret
+			},
+		)
+	}
+
+	if overrides.Unload != nil {
+		pclass.unload = (*[0]byte)(C._goglib_gobject2_TypeModule_unload)
+		classdata.StoreVirtualMethod(
+			unsafe.Pointer(pclass),
+			"_goglib_gobject2_TypeModule_unload",
+			func(carg0 *C.GTypeModule) {
+				var module Instance // go GTypeModule subclass
+
+				module = UnsafeTypeModuleFromGlibBorrow(unsafe.Pointer(carg0)).UnsafeLoadInstanceFromPrivateData().(Instance)
+
+				overrides.Unload(module)
+			},
+		)
+	}
 }
 
-// RegisterInitiallyUnownedSubClass is used to register a go subclass of GInitiallyUnowned. For this to work safely please implement the
+// ParentLoad calls the default implementations of the `GTypeModule.load` virtual method.
+// This function's behavior is not defined when the parent does not implement the virtual method.
+// 
+// see also https://docs.gtk.org/gobject/method.TypeModule.load.html
+func (module *TypeModuleInstance) ParentLoad() bool {
+	var carg0 *C.GTypeModule
+	var cret  C.gboolean // return
+
+	parentclass := (*C.GTypeModuleClass)(classdata.PeekParentClass(UnsafeTypeModuleToGlibNone(module)))
+
+	carg0 = (*C.GTypeModule)(UnsafeTypeModuleToGlibNone(module))
+
+	cret = C._goglib_gobject2_TypeModule_virtual_load(unsafe.Pointer(parentclass.load), carg0)
+	runtime.KeepAlive(module)
+
+	var goret bool
+
+	if cret != 0 {
+		goret = true
+	}
+
+	return goret
+}
+
+// ParentUnload calls the default implementations of the `GTypeModule.unload` virtual method.
+// This function's behavior is not defined when the parent does not implement the virtual method.
+// 
+// see also https://docs.gtk.org/gobject/method.TypeModule.unload.html
+func (module *TypeModuleInstance) ParentUnload() {
+	var carg0 *C.GTypeModule
+
+	parentclass := (*C.GTypeModuleClass)(classdata.PeekParentClass(UnsafeTypeModuleToGlibNone(module)))
+
+	carg0 = (*C.GTypeModule)(UnsafeTypeModuleToGlibNone(module))
+
+	C._goglib_gobject2_TypeModule_virtual_unload(unsafe.Pointer(parentclass.unload), carg0)
+	runtime.KeepAlive(module)
+}
+
+// RegisterTypeModuleSubClass is used to register a go subclass of GTypeModule. For this to work safely please implement the
 // virtual methods required by the implementation.
-func RegisterInitiallyUnownedSubClass[InstanceT InitiallyUnowned](
+func RegisterTypeModuleSubClass[InstanceT TypeModule](
 		name string,
-		classInit func(class *InitiallyUnownedClass),
+		classInit func(class *TypeModuleClass),
 		constructor func() InstanceT,
-		overrides InitiallyUnownedOverrides[InstanceT],
+		overrides TypeModuleOverrides[InstanceT],
 		signals map[string]SignalDefinition,
 		interfaceInits ...SubClassInterfaceInit[InstanceT],
 ) Type {
@@ -2751,11 +3777,11 @@ func RegisterInitiallyUnownedSubClass[InstanceT InitiallyUnowned](
 		constructor,
 		overrides,
 		signals,
-		TypeInitiallyUnowned,
-		UnsafeInitiallyUnownedClassFromGlibBorrow,
-		UnsafeApplyInitiallyUnownedOverrides,
+		TypeTypeModule,
+		UnsafeTypeModuleClassFromGlibBorrow,
+		UnsafeApplyTypeModuleOverrides,
 		func (obj *ObjectInstance) Object {
-			return unsafeWrapInitiallyUnowned(obj)
+			return unsafeWrapTypeModule(obj)
 		},
 		interfaceInits...,
 	)
@@ -3800,6 +4826,143 @@ func UnsafeTypeInstanceToGlibFull(t *TypeInstance) unsafe.Pointer {
 	return _p
 }
 
+// TypeModuleClass wraps GTypeModuleClass
+// 
+// see also https://docs.gtk.org/gobject/struct.TypeModuleClass.html
+// 
+// TypeModuleClass is the type struct for [TypeModule]
+type TypeModuleClass struct {
+	*typeModuleClass
+}
+
+// typeModuleClass is the struct that's finalized
+type typeModuleClass struct {
+	native *C.GTypeModuleClass
+}
+
+// UnsafeTypeModuleClassToGlibNone returns the underlying C pointer. This is used by the bindings internally.
+func (t *TypeModuleClass) instance() *C.GTypeModuleClass {
+	if t == nil {
+		return nil
+	}
+	return t.native
+}
+
+// UnsafeTypeModuleClassFromGlibBorrow is used to convert raw C.GTypeModuleClass pointers to go. This is used by the bindings internally.
+func UnsafeTypeModuleClassFromGlibBorrow(p unsafe.Pointer) *TypeModuleClass {
+	if p == nil {
+		return nil
+	}
+	return &TypeModuleClass{&typeModuleClass{(*C.GTypeModuleClass)(p)}}
+}
+
+// UnsafeTypeModuleClassFree unrefs/frees the underlying resource. This can be used to remove the instance before the GC decides to do so.
+// 
+// After this is called, no other method on [TypeModuleClass] is expected to work anymore.
+func UnsafeTypeModuleClassFree(t *TypeModuleClass) {
+	C.free(unsafe.Pointer(t.native))
+	runtime.SetFinalizer(t.typeModuleClass, nil)
+}
+
+// UnsafeTypeModuleClassToGlibNone returns the underlying C pointer. This is used by the bindings internally.
+func UnsafeTypeModuleClassToGlibNone(t *TypeModuleClass) unsafe.Pointer {
+	if t == nil {
+		return nil
+	}
+	return unsafe.Pointer(t.native)
+}
+
+// ParentClass returns the type struct of the parent class of this type struct.
+// This essentially casts the underlying c pointer.
+func (t *TypeModuleClass) ParentClass() *ObjectClass {
+	parent := UnsafeObjectClassFromGlibBorrow(UnsafeTypeModuleClassToGlibNone(t))
+	// attach a cleanup to keep the instance alive as long as the parent is referenced
+	runtime.AddCleanup(parent, func(_ *TypeModuleClass) {}, t)
+	return parent
+}
+
+// TypePluginClass wraps GTypePluginClass
+// 
+// see also https://docs.gtk.org/gobject/struct.TypePluginClass.html
+type TypePluginClass struct {
+	*typePluginClass
+}
+
+// typePluginClass is the struct that's finalized
+type typePluginClass struct {
+	native *C.GTypePluginClass
+}
+
+// UnsafeTypePluginClassToGlibNone returns the underlying C pointer. This is used by the bindings internally.
+func (t *TypePluginClass) instance() *C.GTypePluginClass {
+	if t == nil {
+		return nil
+	}
+	return t.native
+}
+
+// UnsafeTypePluginClassFromGlibBorrow is used to convert raw C.GTypePluginClass pointers to go. This is used by the bindings internally.
+func UnsafeTypePluginClassFromGlibBorrow(p unsafe.Pointer) *TypePluginClass {
+	if p == nil {
+		return nil
+	}
+	return &TypePluginClass{&typePluginClass{(*C.GTypePluginClass)(p)}}
+}
+
+// UnsafeTypePluginClassFromGlibNone is used to convert raw C.GTypePluginClass pointers to go without transferring ownership. This is used by the bindings internally.
+func UnsafeTypePluginClassFromGlibNone(p unsafe.Pointer) *TypePluginClass {
+	wrapped := UnsafeTypePluginClassFromGlibBorrow(p)
+	if wrapped == nil {
+		return nil
+	}
+
+	log.Println("WARNING: not attaching a finalizer to TypePluginClass because no cgo ref function or copy method is available. This may leak memory. Please file an issue")
+	return wrapped
+}
+
+// UnsafeTypePluginClassFromGlibFull is used to convert raw C.GTypePluginClass pointers to go while taking ownership. This is used by the bindings internally.
+func UnsafeTypePluginClassFromGlibFull(p unsafe.Pointer) *TypePluginClass {
+	wrapped := UnsafeTypePluginClassFromGlibBorrow(p)
+	if wrapped == nil {
+		return nil
+	}
+	runtime.SetFinalizer(
+		wrapped.typePluginClass,
+		func (intern *typePluginClass) {
+			C.free(unsafe.Pointer(intern.native))
+		},
+	)
+	return wrapped
+}
+
+// UnsafeTypePluginClassFree unrefs/frees the underlying resource. This can be used to remove the instance before the GC decides to do so.
+// 
+// After this is called, no other method on [TypePluginClass] is expected to work anymore.
+func UnsafeTypePluginClassFree(t *TypePluginClass) {
+	C.free(unsafe.Pointer(t.native))
+	runtime.SetFinalizer(t.typePluginClass, nil)
+}
+
+// UnsafeTypePluginClassToGlibNone returns the underlying C pointer. This is used by the bindings internally.
+func UnsafeTypePluginClassToGlibNone(t *TypePluginClass) unsafe.Pointer {
+	if t == nil {
+		return nil
+	}
+	return unsafe.Pointer(t.native)
+}
+
+// UnsafeTypePluginClassToGlibFull returns the underlying C pointer and gives up ownership.
+// This is used by the bindings internally.
+func UnsafeTypePluginClassToGlibFull(t *TypePluginClass) unsafe.Pointer {
+	if t == nil {
+		return nil
+	}
+	runtime.SetFinalizer(t.typePluginClass, nil)
+	_p := unsafe.Pointer(t.native)
+	t.native = nil // TypePluginClass is invalid from here on
+	return _p
+}
+
 // TypeValueTable wraps GTypeValueTable
 // 
 // see also https://docs.gtk.org/gobject/struct.TypeValueTable.html
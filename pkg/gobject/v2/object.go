@@ -3,6 +3,8 @@ package gobject
 import (
 	"runtime"
 	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/glib/v2"
 )
 
 // #cgo pkg-config: gobject-2.0
@@ -23,6 +25,7 @@ type Object interface {
 	GoValueInitializer
 
 	Emit(detailedSignal string, args ...any) any
+	EmitWithDetail(signal string, detail glib.Quark, args ...any) any
 
 	Connect(detailedSignal string, f interface{}) SignalHandle
 	ConnectAfter(detailedSignal string, f interface{}) SignalHandle
@@ -31,6 +34,9 @@ type Object interface {
 	HandlerUnblock(SignalHandle)
 	HandlerDisconnect(SignalHandle)
 
+	BindProperty(sourceProperty string, target Object, targetProperty string, flags BindingFlags) Binding
+	BindPropertyFull(sourceProperty string, target Object, targetProperty string, flags BindingFlags, transformTo, transformFrom BindingTransform) Binding
+
 	NotifyProperty(string, func(Object, *ParamSpec)) SignalHandle
 	ObjectProperty(string) interface{}
 	SetObjectProperty(string, interface{})
@@ -262,7 +268,14 @@ func (obj *ObjectInstance) SetObjectProperty(name string, value interface{}) {
 	cstr := C.CString(name)
 	defer C.free(unsafe.Pointer(cstr))
 
-	p := NewValue(value)
+	var p *Value
+	if value == nil {
+		// a nil value unsets object, boxed and string properties. The GValue must be
+		// initialized with the property type, because a nil pointer cannot be transformed
+		p = InitValue(obj.propertyType((*C.gchar)(cstr)))
+	} else {
+		p = NewValue(value)
+	}
 
 	C.g_object_set_property(obj.native, (*C.gchar)(cstr), p.native())
 	runtime.KeepAlive(obj)
@@ -0,0 +1,157 @@
+package gobject
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/closure"
+)
+
+// #include <glib-object.h>
+// extern void _goglib_removeClosure(GObject*, GClosure*);
+// extern void _goglib_bindingTransformMarshal(GClosure*, GValue*, guint, GValue*, gpointer, gpointer);
+import "C"
+
+// BindingTransform converts the value of one property of a binding to the value of the other property.
+// from is the go value of the property, like it is returned by [ObjectInstance.ObjectProperty]. The
+// returned value is converted to the type of the other property. If ok is false, the other property
+// is not updated.
+type BindingTransform func(from any) (to any, ok bool)
+
+// BindProperty wraps g_object_bind_property. The binding keeps sourceProperty of obj and targetProperty
+// of target in sync, until [Binding.Unbind] is called or one of the objects is finalized. It returns nil
+// if the properties can not be bound.
+//
+// see also https://docs.gtk.org/gobject/method.Object.bind_property.html
+func (obj *ObjectInstance) BindProperty(sourceProperty string, target Object, targetProperty string, flags BindingFlags) Binding {
+	return obj.BindPropertyFull(sourceProperty, target, targetProperty, flags, nil, nil)
+}
+
+// BindPropertyFull wraps g_object_bind_property_with_closures. transformTo converts the value of sourceProperty
+// to the value of targetProperty, transformFrom is used in the other direction for bidirectional bindings. If a
+// transform is nil, the default conversion of the GValues is used.
+//
+// The transforms are kept in closures, which are released when the binding is unbound.
+//
+// see also https://docs.gtk.org/gobject/method.Object.bind_property_with_closures.html
+func (obj *ObjectInstance) BindPropertyFull(sourceProperty string, target Object, targetProperty string, flags BindingFlags, transformTo, transformFrom BindingTransform) Binding {
+	cSourceProperty := C.CString(sourceProperty)
+	defer C.free(unsafe.Pointer(cSourceProperty))
+
+	cTargetProperty := C.CString(targetProperty)
+	defer C.free(unsafe.Pointer(cTargetProperty))
+
+	var cTransformTo, cTransformFrom *C.GClosure
+
+	if transformTo != nil {
+		cTransformTo = bindingTransformClosureNew(transformTo)
+		defer C.g_closure_unref(cTransformTo)
+	}
+
+	if transformFrom != nil {
+		cTransformFrom = bindingTransformClosureNew(transformFrom)
+		defer C.g_closure_unref(cTransformFrom)
+	}
+
+	cret := C.g_object_bind_property_with_closures(
+		C.gpointer(obj.unsafe()),
+		(*C.gchar)(cSourceProperty),
+		C.gpointer(target.baseObject().unsafe()),
+		(*C.gchar)(cTargetProperty),
+		C.GBindingFlags(flags),
+		cTransformTo,
+		cTransformFrom,
+	)
+	runtime.KeepAlive(obj)
+	runtime.KeepAlive(target)
+
+	if cret == nil {
+		return nil
+	}
+
+	// the binding is owned by the source and target objects, the go wrapper takes its own reference
+	return UnsafeBindingFromGlibNone(unsafe.Pointer(cret))
+}
+
+// BindingGroupExtManual contains the handwritten methods of [BindingGroup].
+type BindingGroupExtManual interface {
+	// Bind binds sourceProperty of the source of the group to targetProperty of target.
+	Bind(sourceProperty string, target Object, targetProperty string, flags BindingFlags)
+	// BindFull works like Bind, but converts the values with the given transforms.
+	BindFull(sourceProperty string, target Object, targetProperty string, flags BindingFlags, transformTo, transformFrom BindingTransform)
+}
+
+// Bind wraps g_binding_group_bind. The binding is created for every source that is set with SetSource
+// and removed when the source is replaced.
+//
+// see also https://docs.gtk.org/gobject/method.BindingGroup.bind.html
+func (self *BindingGroupInstance) Bind(sourceProperty string, target Object, targetProperty string, flags BindingFlags) {
+	self.BindFull(sourceProperty, target, targetProperty, flags, nil, nil)
+}
+
+// BindFull wraps g_binding_group_bind_with_closures, see [ObjectInstance.BindPropertyFull] for the transforms.
+//
+// see also https://docs.gtk.org/gobject/method.BindingGroup.bind_with_closures.html
+func (self *BindingGroupInstance) BindFull(sourceProperty string, target Object, targetProperty string, flags BindingFlags, transformTo, transformFrom BindingTransform) {
+	cSourceProperty := C.CString(sourceProperty)
+	defer C.free(unsafe.Pointer(cSourceProperty))
+
+	cTargetProperty := C.CString(targetProperty)
+	defer C.free(unsafe.Pointer(cTargetProperty))
+
+	var cTransformTo, cTransformFrom *C.GClosure
+
+	if transformTo != nil {
+		cTransformTo = bindingTransformClosureNew(transformTo)
+		defer C.g_closure_unref(cTransformTo)
+	}
+
+	if transformFrom != nil {
+		cTransformFrom = bindingTransformClosureNew(transformFrom)
+		defer C.g_closure_unref(cTransformFrom)
+	}
+
+	C.g_binding_group_bind_with_closures(
+		(*C.GBindingGroup)(UnsafeBindingGroupToGlibNone(self)),
+		(*C.gchar)(cSourceProperty),
+		C.gpointer(target.baseObject().unsafe()),
+		(*C.gchar)(cTargetProperty),
+		C.GBindingFlags(flags),
+		cTransformTo,
+		cTransformFrom,
+	)
+	runtime.KeepAlive(self)
+	runtime.KeepAlive(target)
+}
+
+// bindingTransformClosureNew creates a GClosure for the transform, that is tracked in the closure registry.
+// The returned GClosure is owned by the caller and must be unref'd when no longer needed.
+func bindingTransformClosureNew(transform BindingTransform) *C.GClosure {
+	fs := closure.NewFuncStack(transform, 2)
+
+	gclosure := C.g_closure_new_simple(C.sizeof_GClosure, nil)
+
+	C.g_closure_set_meta_marshal(gclosure, nil, (*[0]byte)(C._goglib_bindingTransformMarshal))
+	C.g_closure_add_finalize_notifier(gclosure, nil, (*[0]byte)(C._goglib_removeClosure))
+
+	C.g_closure_ref(gclosure)
+	C.g_closure_sink(gclosure)
+
+	closure.Register(unsafe.Pointer(gclosure), fs)
+
+	return gclosure
+}
+
+// setTransformedValue sets the go value to the initialized target value, the value is converted
+// with g_value_transform if the types differ. It returns false if the value can not be converted.
+func setTransformedValue(to *C.GValue, goValue any) bool {
+	if goValue == nil {
+		C.g_value_reset(to)
+		return true
+	}
+
+	src := NewValue(goValue)
+	defer runtime.KeepAlive(src)
+
+	return C.g_value_transform(src.native(), to) != 0
+}
@@ -0,0 +1,47 @@
+package gobject
+
+import (
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/closure"
+)
+
+// #include <glib-object.h>
+import "C"
+
+// _goglib_bindingTransformMarshal is the marshaler of the transform closures of bindings. The closure is invoked
+// with the binding and the source and target values, which are boxed in GValues of type G_TYPE_VALUE. The result
+// is written to the target value.
+//
+//export _goglib_bindingTransformMarshal
+func _goglib_bindingTransformMarshal(
+	gclosure *C.GClosure,
+	retValue *C.GValue,
+	nParams C.guint,
+	params *C.GValue,
+	invocationHint C.gpointer,
+	marshalData C.gpointer,
+) {
+	fs := closure.Load(unsafe.Pointer(gclosure))
+	defer fs.TryRepanic()
+
+	if nParams != 3 {
+		fs.Panicf("binding transform called with %d parameters, expected 3", nParams)
+	}
+
+	transform := fs.Func.(BindingTransform)
+
+	gValues := unsafe.Slice(params, nParams)
+
+	// the boxed values are owned by the caller, so they are not wrapped with a finalizer
+	from := ValueFromNative(unsafe.Pointer(C.g_value_get_boxed(&gValues[1])))
+	to := (*C.GValue)(C.g_value_get_boxed(&gValues[2]))
+
+	result, ok := transform(from.GoValue())
+
+	if ok {
+		ok = setTransformedValue(to, result)
+	}
+
+	C.g_value_set_boolean(retValue, gbool(ok))
+}
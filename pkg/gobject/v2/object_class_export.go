@@ -3,6 +3,7 @@ package gobject
 import (
 	"unsafe"
 
+	"github.com/go-gst/go-glib/pkg/core"
 	"github.com/go-gst/go-glib/pkg/core/classdata"
 )
 
@@ -13,6 +14,8 @@ import "C"
 
 //export _goglib_gobject2_Object_constructed
 func _goglib_gobject2_Object_constructed(carg0 *C.GObject) {
+	defer core.RecoverCallback("_goglib_gobject2_Object_constructed")
+
 	var fn func(carg0 *C.GObject)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gobject2_Object_constructed").(func(carg0 *C.GObject))
@@ -25,6 +28,8 @@ func _goglib_gobject2_Object_constructed(carg0 *C.GObject) {
 
 //export _goglib_gobject2_Object_dispose
 func _goglib_gobject2_Object_dispose(carg0 *C.GObject) {
+	defer core.RecoverCallback("_goglib_gobject2_Object_dispose")
+
 	var fn func(carg0 *C.GObject)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gobject2_Object_dispose").(func(carg0 *C.GObject))
@@ -37,6 +42,8 @@ func _goglib_gobject2_Object_dispose(carg0 *C.GObject) {
 
 //export _goglib_gobject2_Object_get_property
 func _goglib_gobject2_Object_get_property(carg0 *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec) {
+	defer core.RecoverCallback("_goglib_gobject2_Object_get_property")
+
 	var fn func(carg0 *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gobject2_Object_get_property").(func(carg0 *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec))
@@ -49,6 +56,8 @@ func _goglib_gobject2_Object_get_property(carg0 *C.GObject, id C.guint, value *C
 
 //export _goglib_gobject2_Object_set_property
 func _goglib_gobject2_Object_set_property(carg0 *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec) {
+	defer core.RecoverCallback("_goglib_gobject2_Object_set_property")
+
 	var fn func(carg0 *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gobject2_Object_set_property").(func(carg0 *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec))
@@ -61,6 +70,8 @@ func _goglib_gobject2_Object_set_property(carg0 *C.GObject, id C.guint, value *C
 
 //export _goglib_gobject2_Object_finalize
 func _goglib_gobject2_Object_finalize(carg0 *C.GObject) {
+	defer core.RecoverCallback("_goglib_gobject2_Object_finalize")
+
 	var fn func(carg0 *C.GObject)
 	{
 		fn = classdata.LoadVirtualMethodFromInstance(unsafe.Pointer(carg0), "_goglib_gobject2_Object_finalize").(func(carg0 *C.GObject))
@@ -2,8 +2,10 @@ package gobject
 
 import (
 	"fmt"
+	"reflect"
 	"runtime"
-	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/glib/v2"
 )
 
 // #cgo pkg-config: gobject-2.0
@@ -20,34 +22,113 @@ import (
 import "C"
 
 // Emit is a wrapper around g_signal_emitv() and emits the signal
-// specified by the string s to an Object.  Arguments to callback
-// functions connected to this signal must be specified in args.  Emit()
-// returns an interface{} which contains the go equivalent of the C return value.
+// specified by the string s to an Object. s can be a detailed signal name,
+// e.g. "notify::name". Arguments to callback functions connected to this
+// signal must be specified in args.  Emit() returns an interface{} which
+// contains the go equivalent of the C return value.
+//
+// Emit panics if the signal does not exist or if args do not match the
+// parameter types of the signal. nil can be passed for object, boxed and
+// pointer parameters.
 func (obj *ObjectInstance) Emit(s string, args ...any) any {
-	cstr := C.CString(s)
-	defer C.free(unsafe.Pointer(cstr))
+	return obj.emitDetailed(s, signalArgsValues(s, args))
+}
 
-	t := obj.typeFromInstance()
-	id := C.g_signal_lookup((*C.gchar)(cstr), C.GType(t))
+// EmitWithDetail works like Emit, but emits the signal with the given detail. signal must not
+// contain a detail, EmitWithDetail panics if it does.
+func (obj *ObjectInstance) EmitWithDetail(signal string, detail glib.Quark, args ...any) any {
+	id, parsedDetail, ok := parseSignal(signal, obj.typeFromInstance())
 
-	if id == 0 {
-		panic(fmt.Sprintf("signal %s not found for type %s", s, t.Name()))
+	if !ok {
+		panic(fmt.Sprintf("signal %s not found for type %s", signal, obj.typeFromInstance().Name()))
 	}
 
-	// query the signal info to determine the number of arguments and the return type
-	var q C.GSignalQuery
-	C.g_signal_query(id, &q)
+	if parsedDetail != 0 {
+		panic(fmt.Sprintf("signal %s must not contain a detail, the detail is passed separately", signal))
+	}
+
+	if q := querySignal(id); detail != 0 && !q.flags.Has(SignalDetailed) {
+		panic(fmt.Sprintf("signal %s does not support details", signal))
+	}
+
+	return obj.emitValues(id, C.GQuark(detail), signalArgsValues(signal, args))
+}
+
+// signalArgsValues returns a function for emitValues that converts args to the parameter types of the signal.
+func signalArgsValues(s string, args []any) func(paramTypes []Type) []*Value {
+	return func(paramTypes []Type) []*Value {
+		if len(args) != len(paramTypes) {
+			panic(fmt.Sprintf("signal %s has %d parameters, but %d were passed", s, len(paramTypes), len(args)))
+		}
+
+		values := make([]*Value, 0, len(args))
+
+		for i := range args {
+			v, err := signalParamValue(paramTypes[i], args[i])
+
+			if err != nil {
+				panic(fmt.Sprintf("signal %s: emit argument %d: %v", s, i, err))
+			}
+
+			values = append(values, v)
+		}
+
+		return values
+	}
+}
+
+// signalParamValue creates a GValue of the parameter type t that holds arg. nil and nil pointers are
+// passed as NULL if the parameter type allows it. Objects must be an instance of t, which includes
+// implementing t if it is an interface.
+func signalParamValue(t Type, arg any) (*Value, error) {
+	if arg == nil || isNilValue(reflect.ValueOf(arg)) {
+		switch FundamentalType(t) {
+		case TypeObject, TypeInterface, TypeBoxed, TypePointer, TypeParam, TypeVariant, TypeString:
+		default:
+			return nil, fmt.Errorf("nil can not be passed as %s (%s)", t.Name(), FundamentalType(t).Name())
+		}
+
+		if arg != nil {
+			// typed nil pointers must still be of the correct type
+			if argType, err := gtypeForGoType(reflect.TypeOf(arg)); err == nil && argType != t && !argType.IsA(t) {
+				return nil, fmt.Errorf("expected %s, got nil %s", t.Name(), argType.Name())
+			}
+		}
+
+		// a freshly initialized value holds NULL
+		return InitValue(t), nil
+	}
 
-	if len(args) != int(q.n_params) {
-		panic(fmt.Sprintf("signal %s has %d parameters, but %d were passed", s, q.n_params, len(args)))
+	if argType := valueType(arg); argType != t && !argType.IsA(t) {
+		return nil, fmt.Errorf("expected %s (%s), got %s (%s)", t.Name(), FundamentalType(t).Name(), argType.Name(), FundamentalType(argType).Name())
 	}
 
-	// FIXME: signal arg typ checking would be nice here, but currently this breaks passing nil objects, as their
-	// value type is coming from the embedded GObject, which creates a nil dereference when the main pointer is nil
-	// signalArgTypes := unsafe.Slice(q.param_types, q.n_params)
+	v := InitValue(t)
+	v.SetGoValue(arg)
+
+	return v, nil
+}
+
+// emitDetailed emits the detailed signal s with the argument values returned by makeArgs, see emitValues.
+func (obj *ObjectInstance) emitDetailed(s string, makeArgs func(paramTypes []Type) []*Value) any {
+	id, detail, ok := parseSignal(s, obj.typeFromInstance())
 
-	// get the return type, remove the static scope flag first
-	return_type := Type(q.return_type &^ C.G_SIGNAL_TYPE_STATIC_SCOPE)
+	if !ok {
+		panic(fmt.Sprintf("signal %s not found for type %s", s, obj.typeFromInstance().Name()))
+	}
+
+	return obj.emitValues(id, detail, makeArgs)
+}
+
+// emitValues emits the signal with the given id and detail with the argument values returned by makeArgs. makeArgs
+// gets the parameter types of the signal and must return exactly one value per parameter.
+func (obj *ObjectInstance) emitValues(id C.guint, detail C.GQuark, makeArgs func(paramTypes []Type) []*Value) any {
+	// query the signal info to determine the number of arguments and the return type
+	q := querySignal(id)
+
+	args := makeArgs(q.paramTypes)
+
+	return_type := q.returnType
 
 	// Create array of this instance and arguments
 	instanceAndParams := C._alloc_gvalue_list(C.int(len(args)) + 1)
@@ -58,16 +139,7 @@ func (obj *ObjectInstance) Emit(s string, args ...any) any {
 	C._val_list_insert(instanceAndParams, C.int(0), instanceValue.native())
 	defer runtime.KeepAlive(instanceValue) // keep the value alive until the signal has been emitted
 
-	for i := range args {
-		// check the value type
-		// argType := valueType(args[i])
-		// requestedType := Type(signalArgTypes[i])
-
-		// if argType != requestedType && !argType.IsA(requestedType) {
-		// 	panic(fmt.Sprintf("signal emit argument %d has wrong type, expected %s (%s), got %s (%s)", i, requestedType.Name(), FundamentalType(requestedType).Name(), argType.Name(), FundamentalType(argType).Name()))
-		// }
-
-		valueArg := NewValue(args[i])
+	for i, valueArg := range args {
 		C._val_list_insert(instanceAndParams, C.int(i+1), valueArg.native())
 		defer runtime.KeepAlive(valueArg) // keep the value alive until the signal has been emitted
 	}
@@ -80,13 +152,13 @@ func (obj *ObjectInstance) Emit(s string, args ...any) any {
 		ret := InitValue(return_type)
 		defer runtime.KeepAlive(ret) // keep the value alive until the signal has been emitted
 
-		C.g_signal_emitv(instanceAndParams, id, C.GQuark(0), ret.native())
+		C.g_signal_emitv(instanceAndParams, id, detail, ret.native())
 
 		return ret.GoValue()
 	}
 
 	// signal has no return value
-	C.g_signal_emitv(instanceAndParams, id, C.GQuark(0), nil)
+	C.g_signal_emitv(instanceAndParams, id, detail, nil)
 
 	return nil
 }
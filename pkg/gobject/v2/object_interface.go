@@ -0,0 +1,352 @@
+package gobject
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"runtime"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/closure"
+	"github.com/go-gst/go-glib/pkg/core/userdata"
+)
+
+// #include <glib-object.h>
+// extern void _goglibClassInit(gpointer gclass, gpointer classData);
+// static GClosure **_goglib_interface_slot(gpointer iface, guint i) {
+//   return ((GClosure **)((char *)iface + sizeof(GTypeInterface))) + i;
+// }
+// static gpointer _goglib_interface_peek(GObject *obj, GType itype) {
+//   return g_type_interface_peek(G_OBJECT_GET_CLASS(obj), itype);
+// }
+import "C"
+
+// InterfaceDefinition describes a GInterface that is defined in go, see [RegisterInterface].
+type InterfaceDefinition[VTable any] struct {
+	// Prerequisites are the prerequisite types of the interface. If empty, TypeObject is used.
+	Prerequisites []Type
+
+	// Signals are the signals of the interface, the key is the signal name.
+	Signals map[string]SignalDefinition
+
+	// Properties are installed on the interface and must be implemented by every class that
+	// implements the interface.
+	Properties []*ParamSpec
+
+	// Defaults contains the default implementations of the virtual methods, nil functions have
+	// no default implementation.
+	Defaults VTable
+}
+
+// Interface is a GInterface that was registered with [RegisterInterface]. The virtual methods are
+// the func fields of VTable.
+type Interface[VTable any] struct {
+	gtype   Type
+	methods []interfaceMethod
+
+	dispatch VTable
+}
+
+// interfaceMethod is a virtual method of an Interface.
+type interfaceMethod struct {
+	name       string
+	fType      reflect.Type
+	paramTypes []Type
+	returnType Type
+}
+
+// RegisterInterface registers a new GInterface with the given name. VTable must be a struct with exported func
+// fields, each field is a virtual method of the interface. The first parameter of every virtual method is the
+// instance, the GTypes of the other parameters and the return value are derived like in [DefineSignal]:
+//
+//	type GreeterVTable struct {
+//		Greet func(obj gobject.Object, name string) string
+//	}
+//
+//	var Greeter = gobject.RegisterInterface("MyGreeter", gobject.InterfaceDefinition[GreeterVTable]{})
+//
+// The interface struct in C consists of the GTypeInterface followed by one GClosure pointer per virtual method,
+// in the order of the VTable fields. Go classes implement the interface with [ImplementInterface], C classes set
+// the closures in their interface init function, e.g. with g_cclosure_new and g_cclosure_marshal_generic.
+// [Interface.Dispatch] calls the implementation of an instance.
+func RegisterInterface[VTable any](name string, def InterfaceDefinition[VTable]) *Interface[VTable] {
+	vtableType := reflect.TypeFor[VTable]()
+
+	if vtableType.Kind() != reflect.Struct {
+		log.Panicf("interface %s: VTable must be a struct, got %s", name, vtableType)
+	}
+
+	iface := &Interface[VTable]{}
+
+	for i := range vtableType.NumField() {
+		field := vtableType.Field(i)
+
+		m, err := newInterfaceMethod(field)
+		if err != nil {
+			log.Panicf("interface %s: virtual method %s: %v", name, field.Name, err)
+		}
+
+		iface.methods = append(iface.methods, m)
+	}
+
+	prerequisites := def.Prerequisites
+	if len(prerequisites) == 0 {
+		prerequisites = []Type{TypeObject}
+	}
+
+	data := &subClassData{
+		classInit: func(giface unsafe.Pointer) {
+			// the default init of the interface is called once, when the interface is first used
+			iface.applyVTable(giface, def.Defaults, nil)
+
+			for _, prop := range def.Properties {
+				C.g_object_interface_install_property(C.gpointer(giface), (*C.GParamSpec)(UnsafeParamSpecToGlibFull(prop)))
+			}
+
+			for signal, sd := range def.Signals {
+				sd.registerFor(signal, iface.gtype)
+			}
+		},
+	}
+
+	typeInfo := &C.GTypeInfo{
+		class_size: C.guint16(C.sizeof_GTypeInterface + len(iface.methods)*int(unsafe.Sizeof(uintptr(0)))),
+		class_init: C.GClassInitFunc(C._goglibClassInit),
+		class_data: C.gconstpointer(userdata.Register(data)),
+	}
+
+	gtype := registerType(TypeInterface, name, typeInfo, C.GTypeFlags(0))
+
+	if gtype == TypeInvalid {
+		log.Panicf("interface %s could not be registered", name)
+	}
+
+	for _, prerequisite := range prerequisites {
+		C.g_type_interface_add_prerequisite(C.GType(gtype), C.GType(prerequisite))
+	}
+
+	iface.gtype = gtype
+	iface.dispatch = iface.newDispatch()
+
+	return iface
+}
+
+func newInterfaceMethod(field reflect.StructField) (interfaceMethod, error) {
+	if !field.IsExported() {
+		return interfaceMethod{}, fmt.Errorf("field must be exported")
+	}
+
+	fType := field.Type
+
+	if fType.Kind() != reflect.Func || fType.NumIn() == 0 || fType.IsVariadic() {
+		return interfaceMethod{}, fmt.Errorf("must be a non variadic func with the instance as first parameter, got %s", fType)
+	}
+
+	if k := fType.In(0).Kind(); k != reflect.Interface && k != reflect.Pointer {
+		return interfaceMethod{}, fmt.Errorf("the instance parameter must be an interface or pointer, got %s", fType.In(0))
+	}
+
+	m := interfaceMethod{
+		name:       field.Name,
+		fType:      fType,
+		returnType: TypeNone,
+	}
+
+	for i := 1; i < fType.NumIn(); i++ {
+		t, err := gtypeForGoType(fType.In(i))
+		if err != nil {
+			return m, fmt.Errorf("parameter %d: %w", i, err)
+		}
+
+		m.paramTypes = append(m.paramTypes, t)
+	}
+
+	switch fType.NumOut() {
+	case 0:
+	case 1:
+		t, err := gtypeForGoType(fType.Out(0))
+		if err != nil {
+			return m, fmt.Errorf("return value: %w", err)
+		}
+
+		m.returnType = t
+	default:
+		return m, fmt.Errorf("at most one return value is supported, got %s", fType)
+	}
+
+	return m, nil
+}
+
+// Type returns the GType of the interface.
+func (i *Interface[VTable]) Type() Type {
+	return i.gtype
+}
+
+// Dispatch returns a VTable whose functions call the implementation of the instance that is passed as
+// the first parameter. It panics if the instance does not implement the virtual method.
+func (i *Interface[VTable]) Dispatch() VTable {
+	return i.dispatch
+}
+
+// Implements returns true if obj implements the interface.
+func (i *Interface[VTable]) Implements(obj Object) bool {
+	return obj.baseObject().typeFromInstance().IsA(i.gtype)
+}
+
+// ImplementInterface returns the SubClassInterfaceInit that implements iface for a go subclass with the
+// given virtual methods. It can be passed to the subclass registration. The instance parameter of the
+// virtual methods may also be the instance type of the subclass.
+func ImplementInterface[InstanceT Object, VTable any](iface *Interface[VTable], vtable VTable) SubClassInterfaceInit[InstanceT] {
+	return SubClassInterfaceInit[InstanceT]{
+		InterfaceType: iface.gtype,
+		ApplyOverrides: func(giface unsafe.Pointer) {
+			iface.applyVTable(giface, vtable, reflect.TypeFor[InstanceT]())
+		},
+		goInterface: true,
+	}
+}
+
+// applyVTable stores a closure for each non nil function of vtable in the interface struct. If instanceType
+// is not nil, the go subclass instance is passed as the instance to the functions.
+func (i *Interface[VTable]) applyVTable(giface unsafe.Pointer, vtable VTable, instanceType reflect.Type) {
+	vtableValue := reflect.ValueOf(vtable)
+
+	for idx, m := range i.methods {
+		fn := vtableValue.Field(idx)
+
+		if fn.IsNil() {
+			continue
+		}
+
+		gclosure := closureNew()
+
+		closure.Register(unsafe.Pointer(gclosure), closure.NewFuncStack(m.goHandler(fn, instanceType), 1))
+
+		// the interface struct keeps the reference of the closure, interfaces of static types are never finalized.
+		// A closure that is already in the slot is not unref'd, GObject copies the slots from the default
+		// interface or from the parent class, so it is still used by them.
+		slot := C._goglib_interface_slot(C.gpointer(giface), C.guint(idx))
+		*slot = gclosure
+	}
+}
+
+// goHandler wraps fn in a func that can be called by the closure marshaler, the instance is passed as Object.
+func (m interfaceMethod) goHandler(fn reflect.Value, instanceType reflect.Type) any {
+	in := []reflect.Type{reflect.TypeFor[Object]()}
+	for idx := 1; idx < m.fType.NumIn(); idx++ {
+		in = append(in, m.fType.In(idx))
+	}
+
+	var out []reflect.Type
+	if m.fType.NumOut() == 1 {
+		out = append(out, m.fType.Out(0))
+	}
+
+	instanceParam := m.fType.In(0)
+
+	return reflect.MakeFunc(reflect.FuncOf(in, out, false), func(args []reflect.Value) []reflect.Value {
+		obj := args[0].Interface().(Object)
+
+		var instance any = obj
+
+		if instanceType != nil && !reflect.TypeOf(obj).AssignableTo(instanceParam) {
+			// the implementation wants the go subclass instance
+			instance = obj.baseObject().UnsafeLoadInstanceFromPrivateData()
+		}
+
+		args[0] = reflect.ValueOf(instance)
+
+		if !args[0].Type().AssignableTo(instanceParam) {
+			log.Panicf("virtual method %s: instance %s can not be passed as %s", m.name, args[0].Type(), instanceParam)
+		}
+
+		return fn.Call(args)
+	}).Interface()
+}
+
+// newDispatch creates the VTable that invokes the closures of the implementations.
+func (i *Interface[VTable]) newDispatch() VTable {
+	var dispatch VTable
+
+	dv := reflect.ValueOf(&dispatch).Elem()
+
+	for idx, m := range i.methods {
+		dv.Field(idx).Set(reflect.MakeFunc(m.fType, func(args []reflect.Value) []reflect.Value {
+			obj, ok := args[0].Interface().(Object)
+			if !ok || obj == nil {
+				log.Panicf("virtual method %s: instance must be a non nil Object, got %s", m.name, args[0].Type())
+			}
+
+			goArgs := make([]any, 0, len(args)-1)
+			for _, a := range args[1:] {
+				goArgs = append(goArgs, a.Interface())
+			}
+
+			ret := i.invoke(obj, idx, goArgs)
+
+			if m.returnType == TypeNone {
+				return nil
+			}
+
+			if ret == nil {
+				return []reflect.Value{reflect.Zero(m.fType.Out(0))}
+			}
+
+			return []reflect.Value{reflect.ValueOf(ret).Convert(m.fType.Out(0))}
+		}))
+	}
+
+	return dispatch
+}
+
+// invoke calls the closure of the virtual method idx that is set in the interface struct of the instance.
+func (i *Interface[VTable]) invoke(obj Object, idx int, args []any) any {
+	m := i.methods[idx]
+	base := obj.baseObject()
+
+	giface := C._goglib_interface_peek((*C.GObject)(base.unsafe()), C.GType(i.gtype))
+	if giface == nil {
+		log.Panicf("virtual method %s: %s does not implement %s", m.name, base.typeFromInstance().Name(), i.gtype.Name())
+	}
+
+	gclosure := *C._goglib_interface_slot(giface, C.guint(idx))
+	if gclosure == nil {
+		log.Panicf("virtual method %s of %s is not implemented by %s", m.name, i.gtype.Name(), base.typeFromInstance().Name())
+	}
+
+	values := make([]*Value, 0, len(args)+1)
+	values = append(values, NewValue(base))
+
+	for pi, arg := range args {
+		v, err := signalParamValue(m.paramTypes[pi], arg)
+		if err != nil {
+			log.Panicf("virtual method %s: argument %d: %v", m.name, pi+1, err)
+		}
+
+		values = append(values, v)
+	}
+
+	// g_closure_invoke needs the values in a contiguous array
+	params := make([]C.GValue, len(values))
+	for vi, v := range values {
+		params[vi] = *v.native()
+	}
+
+	var ret *Value
+	var cret *C.GValue
+
+	if m.returnType != TypeNone {
+		ret = InitValue(m.returnType)
+		cret = ret.native()
+	}
+
+	C.g_closure_invoke(gclosure, cret, C.guint(len(params)), unsafe.SliceData(params), nil)
+
+	runtime.KeepAlive(values)
+
+	if ret == nil {
+		return nil
+	}
+
+	return ret.GoValue()
+}
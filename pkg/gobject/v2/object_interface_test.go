@@ -0,0 +1,100 @@
+package gobject
+
+import "testing"
+
+type interfaceTestVTable struct {
+	Greet func(obj Object, name string) string
+	Count func(obj Object) int32
+}
+
+var interfaceTestGreeter = RegisterInterface("GoglibInterfaceTestGreeter", InterfaceDefinition[interfaceTestVTable]{
+	Defaults: interfaceTestVTable{
+		Count: func(obj Object) int32 {
+			return -1
+		},
+	},
+})
+
+type interfaceTestImpl struct {
+	ObjectInstance
+
+	greeted int32
+}
+
+var interfaceTestImplType = RegisterObjectSubClass[*interfaceTestImpl](
+	"GoglibInterfaceTestImpl",
+	nil,
+	nil,
+	ObjectOverrides[*interfaceTestImpl]{},
+	nil,
+	ImplementInterface[*interfaceTestImpl](interfaceTestGreeter, interfaceTestVTable{
+		Greet: func(obj Object, name string) string {
+			obj.baseObject().UnsafeLoadInstanceFromPrivateData().(*interfaceTestImpl).greeted++
+
+			return "hello " + name
+		},
+	}),
+)
+
+func TestInterfaceDispatch(t *testing.T) {
+	obj := NewObjectWithProperties(interfaceTestImplType, nil)
+
+	if !interfaceTestGreeter.Implements(obj) {
+		t.Fatalf("Expected %s to implement %s", interfaceTestImplType.Name(), interfaceTestGreeter.Type().Name())
+	}
+
+	greeter := interfaceTestGreeter.Dispatch()
+
+	if greeting := greeter.Greet(obj, "world"); greeting != "hello world" {
+		t.Fatalf("Expected hello world, got %s", greeting)
+	}
+
+	if count := greeter.Count(obj); count != -1 {
+		t.Fatalf("Expected the default implementation to return -1, got %d", count)
+	}
+
+	impl := obj.baseObject().UnsafeLoadInstanceFromPrivateData().(*interfaceTestImpl)
+
+	if impl.greeted != 1 {
+		t.Fatalf("Expected the implementation to be called once, got %d", impl.greeted)
+	}
+}
+
+func TestInterfaceNotImplemented(t *testing.T) {
+	obj := NewObjectWithProperties(TypeObject, nil)
+
+	if interfaceTestGreeter.Implements(obj) {
+		t.Fatalf("Expected GObject not to implement %s", interfaceTestGreeter.Type().Name())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected Dispatch to panic for an instance that does not implement the interface")
+		}
+	}()
+
+	interfaceTestGreeter.Dispatch().Greet(obj, "world")
+}
+
+type interfaceTestForeign struct {
+	ObjectInstance
+}
+
+func TestSubClassWithForeignInterfacePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected the registration to panic for an interface that was not created with ImplementInterface")
+		}
+	}()
+
+	RegisterObjectSubClass[*interfaceTestForeign](
+		"GoglibInterfaceTestForeign",
+		nil,
+		nil,
+		ObjectOverrides[*interfaceTestForeign]{},
+		nil,
+		SubClassInterfaceInit[*interfaceTestForeign]{
+			InterfaceType: interfaceTestGreeter.Type(),
+		},
+	)
+}
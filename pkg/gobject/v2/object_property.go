@@ -0,0 +1,48 @@
+package gobject
+
+import (
+	"log"
+	"reflect"
+)
+
+// ObjectPropertyAs gets the property with the given name and converts it to T. It is used
+// by the generated typed property getters.
+//
+// Numeric values are converted to T if needed, because the GValue marshalers do not know the
+// go type of the property, e.g. a gint property is returned as int by [ObjectInstance.ObjectProperty].
+// It will panic if the property does not exist or can not be converted to T.
+func ObjectPropertyAs[T any](obj Object, name string) T {
+	v := obj.ObjectProperty(name)
+
+	ret, ok := goValueAs[T](v)
+
+	if !ok {
+		if _, invalid := v.(invalidValueType); invalid {
+			log.Panicf("property %q could not be read", name)
+		}
+
+		log.Panicf("property %q: cannot convert %T to %s", name, v, reflect.TypeFor[T]())
+	}
+
+	return ret
+}
+
+// goValueAs converts a value returned by [Value.GoValue] to T. nil is converted to the zero value
+// and numeric values are converted if needed. ok is false if v can not be converted to T.
+func goValueAs[T any](v any) (ret T, ok bool) {
+	switch v := v.(type) {
+	case nil:
+		return ret, true
+	case T:
+		return v, true
+	default:
+		rv := reflect.ValueOf(v)
+		target := reflect.TypeFor[T]()
+
+		if rv.CanConvert(target) {
+			return rv.Convert(target).Interface().(T), true
+		}
+	}
+
+	return ret, false
+}
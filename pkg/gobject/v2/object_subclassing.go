@@ -22,6 +22,11 @@ type subClassData struct {
 // UnsafeRegisterSubClass registers a new subclass of the given parentGtype. This is wrapped by the generated bindings
 // for ease of use.
 // This aligns with https://gjs.guide/guides/gobject/subclassing.html
+//
+// Fields of the instance struct can be declared as properties with a gprop struct tag, e.g.
+// `gprop:"volume,readwrite,construct,min=0,max=10"`. The ParamSpecs are installed automatically and
+// the property get/set is dispatched to the fields, notify is emitted when a set changes the value.
+// Properties installed manually in classInit are still passed to the GetProperty and SetProperty overrides.
 func UnsafeRegisterSubClass[InstanceT Object, ClassT any, OverridesT ObjectOverrider[InstanceT]](
 	// user supplied arguments:
 	name string,
@@ -57,12 +62,18 @@ func UnsafeRegisterSubClass[InstanceT Object, ClassT any, OverridesT ObjectOverr
 
 	baseOverrides := overrides.getObjectOverrides()
 
+	// parse the gprop struct tags early, so invalid tags panic here
+	properties := newTaggedProperties(instanceType)
+
 	var data *subClassData
 	data = &subClassData{
 		classInit: func(gclass unsafe.Pointer) {
 			// first override the virtual methods on the class
 			parentApplyOverridesFunc(gclass, overrides)
 
+			// install the tagged properties, this wraps the property overrides
+			properties.install(gclass)
+
 			class := parentClassFromUnsafePointer(gclass)
 
 			// gtype is the type of the instance
@@ -131,12 +142,23 @@ func UnsafeRegisterSubClass[InstanceT Object, ClassT any, OverridesT ObjectOverr
 			// store the instance in the private data of the instance, so we can retrieve it later
 			saveInstanceInPrivateData(instance)
 
+			properties.initDefaults(obj.loadActiveInstance())
+
 			if baseOverrides.InstanceInit != nil {
 				baseOverrides.InstanceInit(instance)
 			}
 		},
 	}
 
+	for _, iface := range interfaceInits {
+		if !iface.goInterface {
+			// TODO: for interfaces of the generated bindings we need to set the interface Instance types in the class init
+			// function as well and require that the interfaces are registered in the same order as the user provided
+			// embedded interfaces. Interfaces registered with RegisterInterface don't need this.
+			log.Panicf("subclass %s: interface %s: only interfaces created with ImplementInterface are supported", name, iface.InterfaceType.Name())
+		}
+	}
+
 	dataKey := userdata.Register(data)
 
 	// this can be allocated by go because the parameter is owned by the caller
@@ -155,25 +177,13 @@ func UnsafeRegisterSubClass[InstanceT Object, ClassT any, OverridesT ObjectOverr
 		class_data:    C.gconstpointer(dataKey),
 	}
 
-	cName := C.CString(name)
-	defer C.free(unsafe.Pointer(cName))
-
-	gtype := C.g_type_register_static(
-		C.GType(parentGtype),
-		(*C.gchar)(cName),
-		typeInfo,
-		C.GTypeFlags(0),
-	)
+	// the type is registered with the loading type module, if there is one, see NewTypeModule
+	gtype := registerType(parentGtype, name, typeInfo, C.GTypeFlags(0))
 
 	// register the interfaces
 	for _, iface := range interfaceInits {
-		panic("TODO: interface init is not implemented")
-
-		// TODO: we need to set the interface Instance types in the class init function as well and require that
-		// the interfaces are registered in the same order as the user provided embedded interfaces
-
 		ifaceInfo := iface.toInterfaceInfo()
-		C.g_type_add_interface_static(gtype, C.GType(iface.InterfaceType), ifaceInfo)
+		addInterface(gtype, iface.InterfaceType, ifaceInfo)
 	}
 
 	t := Type(gtype)
@@ -212,6 +222,10 @@ type SubClassInterfaceInit[InstanceT Object] struct {
 	InterfaceType  Type
 	ApplyOverrides func(gclass unsafe.Pointer)
 	FromGlib       func(unsafe.Pointer) any
+
+	// goInterface is set by ImplementInterface, only interfaces registered with RegisterInterface
+	// can be implemented by go subclasses for now.
+	goInterface bool
 }
 
 // toInterfaceInfo returns the GInterfaceInfo struct for the given interface type.
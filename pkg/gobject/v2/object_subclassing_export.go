@@ -5,6 +5,7 @@ import "C"
 import (
 	"unsafe"
 
+	"github.com/go-gst/go-glib/pkg/core"
 	"github.com/go-gst/go-glib/pkg/core/userdata"
 )
 
@@ -13,6 +14,8 @@ import (
 //
 //export _goglibInterfaceInit
 func _goglibInterfaceInit(instance C.gpointer, ifaceData C.gpointer) {
+	defer core.RecoverCallback("_goglibInterfaceInit")
+
 	ptr := unsafe.Pointer(ifaceData)
 	// if the interfaceData is deleted then we can never extend the new class.
 	// defer userdata.Delete(ptr)
@@ -24,7 +27,8 @@ func _goglibInterfaceInit(instance C.gpointer, ifaceData C.gpointer) {
 }
 
 // _goglibClassInit is the function that is called by the GObject system when the class is initialized on
-// the subclass. This is called only once and applies the class overrides.
+// the subclass. This is called only once and applies the class overrides. Panics are not recovered, the
+// class would be left half initialized.
 //
 //export _goglibClassInit
 func _goglibClassInit(gclass C.gpointer, classData C.gpointer) {
@@ -38,7 +42,8 @@ func _goglibClassInit(gclass C.gpointer, classData C.gpointer) {
 }
 
 // _goglibInstanceInit is the function that is called by the GObject system when the instance is initialized on
-// the subclass. This is called for each instance and applies the instance overrides.
+// the subclass. This is called for each instance and applies the instance overrides. Panics are not
+// recovered, the instance would not be linked to its go value.
 //
 //export _goglibInstanceInit
 func _goglibInstanceInit(instance *C.GTypeInstance, gclass C.gpointer) {
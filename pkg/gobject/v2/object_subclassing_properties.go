@@ -0,0 +1,560 @@
+package gobject
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/classdata"
+)
+
+// #include <glib-object.h>
+// extern void _goglib_gobject2_Object_get_property(GObject *, guint, GValue *, GParamSpec *);
+// extern void _goglib_gobject2_Object_set_property(GObject *, guint, GValue *, GParamSpec *);
+// static gint _goglib_first_enum_value(GType t) {
+//   GEnumClass *c = g_type_class_ref(t);
+//   gint v = c->n_values > 0 ? c->values[0].value : 0;
+//   g_type_class_unref(c);
+//   return v;
+// }
+import "C"
+
+// taggedPropertyIDOffset is added to the ids of the properties declared with gprop struct tags, so they
+// don't collide with the properties installed with [ObjectClass.InstallProperties].
+const taggedPropertyIDOffset = 1 << 16
+
+// taggedProperty is a property that is declared with a gprop struct tag on a field of the instance struct:
+//
+//	type MyElement struct {
+//		gst.ElementInstance
+//
+//		Volume float64 `gprop:"volume,readwrite,construct,min=0,max=10,default=1,nick=Volume,blurb=The volume"`
+//	}
+//
+// The first value of the tag is the property name, followed by flags and key=value options:
+//
+//   - read, write, readwrite: the access of the property. readwrite is the default
+//   - construct, construct-only, lax-validation, deprecated: the equivalent [ParamFlags]
+//   - min, max: the range of numeric properties. Defaults to the range of the field type
+//   - default: the default value of the property, the field is initialized with it
+//   - nick, blurb: the descriptions of the property
+//
+// The GType of the property is derived from the field type, see [DefineSignal].
+type taggedProperty struct {
+	name  string
+	nick  string
+	blurb string
+	flags ParamFlags
+
+	options map[string]string
+
+	fieldIndex []int
+	fieldType  reflect.Type
+	gtype      Type
+
+	// spec is set when the property is installed on the class
+	spec *ParamSpec
+}
+
+// taggedProperties are the tagged properties of a subclass.
+type taggedProperties struct {
+	// instanceType is the instance struct of the subclass. The instances of go subclasses of the subclass
+	// embed it as their first field.
+	instanceType reflect.Type
+
+	props []*taggedProperty
+}
+
+// propertyFunc is the signature of the get_property and set_property virtual methods.
+type propertyFunc = func(carg0 *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec)
+
+// newTaggedProperties parses the gprop struct tags of the instance struct. It panics on invalid tags, so
+// errors are caught when registering the subclass.
+func newTaggedProperties(instanceType reflect.Type) *taggedProperties {
+	tp := &taggedProperties{
+		instanceType: instanceType,
+	}
+
+	for i := range instanceType.NumField() {
+		field := instanceType.Field(i)
+
+		tag, ok := field.Tag.Lookup("gprop")
+		if !ok || tag == "-" {
+			continue
+		}
+
+		p, err := parseTaggedProperty(field, tag)
+		if err != nil {
+			log.Panicf("%s.%s: invalid gprop tag: %v", instanceType, field.Name, err)
+		}
+
+		tp.props = append(tp.props, p)
+	}
+
+	return tp
+}
+
+func parseTaggedProperty(field reflect.StructField, tag string) (*taggedProperty, error) {
+	parts := strings.Split(tag, ",")
+
+	p := &taggedProperty{
+		name:       parts[0],
+		options:    make(map[string]string),
+		fieldIndex: field.Index,
+		fieldType:  field.Type,
+	}
+
+	if p.name == "" {
+		return nil, fmt.Errorf("missing property name")
+	}
+
+	var access ParamFlags
+
+	for _, part := range parts[1:] {
+		if key, value, ok := strings.Cut(part, "="); ok {
+			switch key {
+			case "min", "max", "default":
+				p.options[key] = value
+			case "nick":
+				p.nick = value
+			case "blurb":
+				p.blurb = value
+			default:
+				return nil, fmt.Errorf("unknown option %q", key)
+			}
+
+			continue
+		}
+
+		switch part {
+		case "read", "readable":
+			access |= ParamReadable
+		case "write", "writable":
+			access |= ParamWritable
+		case "readwrite":
+			access |= ParamReadwrite
+		case "construct":
+			p.flags |= ParamConstruct
+		case "construct-only":
+			p.flags |= ParamConstructOnly
+		case "lax-validation":
+			p.flags |= ParamLaxValidation
+		case "deprecated":
+			p.flags |= ParamDeprecated
+		default:
+			return nil, fmt.Errorf("unknown flag %q", part)
+		}
+	}
+
+	if access == 0 {
+		access = ParamReadwrite
+	}
+
+	// notify is emitted by the bindings only when the value changed
+	p.flags |= access | ParamExplicitNotify
+
+	if p.flags&(ParamConstruct|ParamConstructOnly) != 0 && p.flags&ParamWritable == 0 {
+		return nil, fmt.Errorf("construct properties must be writable")
+	}
+
+	gtype, err := gtypeForGoType(field.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	p.gtype = gtype
+
+	return p, nil
+}
+
+// paramSpec creates the ParamSpec for the property.
+func (p *taggedProperty) paramSpec() (*ParamSpec, error) {
+	switch FundamentalType(p.gtype) {
+	case TypeBoolean:
+		def, err := parseOption(p, "default", false, strconv.ParseBool)
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecBoolean(p.name, p.nick, p.blurb, def, p.flags), nil
+	case TypeChar:
+		min, max, def, err := intRange(p, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecChar(p.name, p.nick, p.blurb, int8(min), int8(max), int8(def), p.flags), nil
+	case TypeUchar:
+		min, max, def, err := uintRange(p, math.MaxUint8)
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecUchar(p.name, p.nick, p.blurb, uint8(min), uint8(max), uint8(def), p.flags), nil
+	case TypeInt:
+		min, max, def, err := intRange(p, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecInt32(p.name, p.nick, p.blurb, int32(min), int32(max), int32(def), p.flags), nil
+	case TypeUint:
+		min, max, def, err := uintRange(p, math.MaxUint32)
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecUint(p.name, p.nick, p.blurb, uint(min), uint(max), uint(def), p.flags), nil
+	case TypeInt64:
+		min, max, def, err := intRange(p, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecInt64(p.name, p.nick, p.blurb, min, max, def, p.flags), nil
+	case TypeUint64:
+		min, max, def, err := uintRange(p, math.MaxUint64)
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecUint64(p.name, p.nick, p.blurb, min, max, def, p.flags), nil
+	case TypeFloat:
+		min, max, def, err := floatRange(p, math.MaxFloat32)
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecFloat(p.name, p.nick, p.blurb, float32(min), float32(max), float32(def), p.flags), nil
+	case TypeDouble:
+		min, max, def, err := floatRange(p, math.MaxFloat64)
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecDouble(p.name, p.nick, p.blurb, min, max, def, p.flags), nil
+	case TypeString:
+		return ParamSpecString(p.name, p.nick, p.blurb, p.options["default"], p.flags), nil
+	case TypeEnum:
+		def, err := parseOption(p, "default", int64(C._goglib_first_enum_value(C.GType(p.gtype))), parseInt(32))
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecEnum(p.name, p.nick, p.blurb, p.gtype, int32(def), p.flags), nil
+	case TypeBitflags:
+		def, err := parseOption(p, "default", 0, parseUint(32))
+		if err != nil {
+			return nil, err
+		}
+		return ParamSpecFlags(p.name, p.nick, p.blurb, p.gtype, uint(def), p.flags), nil
+	case TypeBoxed:
+		return ParamSpecBoxed(p.name, p.nick, p.blurb, p.gtype, p.flags), nil
+	case TypeObject, TypeInterface:
+		return ParamSpecObject(p.name, p.nick, p.blurb, p.gtype, p.flags), nil
+	}
+
+	return nil, fmt.Errorf("properties of type %s are not supported", p.gtype.Name())
+}
+
+// field returns the settable field of the property in the given instance struct.
+func (p *taggedProperty) field(instance reflect.Value) reflect.Value {
+	f := instance.FieldByIndex(p.fieldIndex)
+
+	if !f.CanSet() {
+		// unexported fields are allowed as the storage of properties
+		f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+	}
+
+	return f
+}
+
+// instanceStruct returns the instance struct of the subclass in the given instance, which is the
+// instance of the subclass or of a go subclass of it.
+func (tp *taggedProperties) instanceStruct(instance Object) reflect.Value {
+	v := reflect.ValueOf(instance).Elem()
+
+	for v.Type() != tp.instanceType {
+		if v.Kind() != reflect.Struct || v.NumField() == 0 {
+			log.Panicf("%s does not embed %s", reflect.TypeOf(instance), tp.instanceType)
+		}
+
+		v = v.Field(0)
+	}
+
+	return v
+}
+
+// install installs the properties on the class and dispatches the get_property and set_property virtual
+// methods for the tagged properties. The properties of go parent classes are passed to the virtual methods
+// of the class that installed them, other property ids are passed to the overrides of the subclass.
+func (tp *taggedProperties) install(gclass unsafe.Pointer) {
+	pclass := (*C.GObjectClass)(gclass)
+	gtype := (*C.GTypeClass)(gclass).g_type
+
+	for i, p := range tp.props {
+		spec, err := p.paramSpec()
+		if err != nil {
+			log.Panicf("property %s: %v", p.name, err)
+		}
+
+		p.spec = spec
+
+		// the class takes over the reference of the spec and keeps it alive
+		C.g_object_class_install_property(pclass, C.guint(taggedPropertyIDOffset+i), (*C.GParamSpec)(UnsafeParamSpecToGlibFull(spec)))
+	}
+
+	prevGet, _ := classdata.LoadVirtualMethod(gclass, "_goglib_gobject2_Object_get_property").(propertyFunc)
+	prevSet, _ := classdata.LoadVirtualMethod(gclass, "_goglib_gobject2_Object_set_property").(propertyFunc)
+
+	pclass.get_property = (*[0]byte)(C._goglib_gobject2_Object_get_property)
+	classdata.StoreVirtualMethod(
+		gclass,
+		"_goglib_gobject2_Object_get_property",
+		func(carg0 *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec) {
+			if p := tp.lookup(uint(id), pspec); p != nil {
+				instance := UnsafeObjectFromGlibBorrow(unsafe.Pointer(carg0)).loadActiveInstance()
+
+				tp.get(instance, p, ValueFromNative(unsafe.Pointer(value)))
+				return
+			}
+
+			if owner := ownerPropertyFunc(gtype, pspec, "_goglib_gobject2_Object_get_property"); owner != nil {
+				owner(carg0, id, value, pspec)
+				return
+			}
+
+			if prevGet == nil {
+				log.Panicf("invalid property id %d for property %s", id, C.GoString((*C.char)(C.g_param_spec_get_name(pspec))))
+			}
+
+			prevGet(carg0, id, value, pspec)
+		},
+	)
+
+	pclass.set_property = (*[0]byte)(C._goglib_gobject2_Object_set_property)
+	classdata.StoreVirtualMethod(
+		gclass,
+		"_goglib_gobject2_Object_set_property",
+		func(carg0 *C.GObject, id C.guint, value *C.GValue, pspec *C.GParamSpec) {
+			if p := tp.lookup(uint(id), pspec); p != nil {
+				instance := UnsafeObjectFromGlibBorrow(unsafe.Pointer(carg0)).loadActiveInstance()
+
+				if tp.set(instance, p, ValueFromNative(unsafe.Pointer(value))) {
+					C.g_object_notify_by_pspec(carg0, pspec)
+				}
+				return
+			}
+
+			if owner := ownerPropertyFunc(gtype, pspec, "_goglib_gobject2_Object_set_property"); owner != nil {
+				owner(carg0, id, value, pspec)
+				return
+			}
+
+			if prevSet == nil {
+				log.Panicf("invalid property id %d for property %s", id, C.GoString((*C.char)(C.g_param_spec_get_name(pspec))))
+			}
+
+			prevSet(carg0, id, value, pspec)
+		},
+	)
+}
+
+// lookup returns the tagged property with the given property id and spec, or nil. The ids of the tagged
+// properties of go parent classes are the same, so the spec must be checked as well.
+func (tp *taggedProperties) lookup(id uint, pspec *C.GParamSpec) *taggedProperty {
+	if id < taggedPropertyIDOffset || id-taggedPropertyIDOffset >= uint(len(tp.props)) {
+		return nil
+	}
+
+	p := tp.props[id-taggedPropertyIDOffset]
+
+	if UnsafeParamSpecToGlibNone(p.spec) != unsafe.Pointer(pspec) {
+		return nil
+	}
+
+	return p
+}
+
+// ownerPropertyFunc returns the given property virtual method of the class that installed pspec, if that
+// is a parent class of the class with the given gtype and stored the virtual method. GObject calls the
+// virtual method of the class that installed the property, but the trampoline dispatches to the class of
+// the instance.
+func ownerPropertyFunc(gtype C.GType, pspec *C.GParamSpec, name string) propertyFunc {
+	if pspec.owner_type == gtype {
+		return nil
+	}
+
+	owner, _ := classdata.LoadVirtualMethod(unsafe.Pointer(C.g_type_class_peek(pspec.owner_type)), name).(propertyFunc)
+
+	return owner
+}
+
+// initDefaults sets the fields of non construct properties to the default value of the property. Construct
+// properties are set by GObject when the instance is constructed.
+func (tp *taggedProperties) initDefaults(instance *activeInstance) {
+	for _, p := range tp.props {
+		if _, ok := p.options["default"]; !ok || p.flags&(ParamConstruct|ParamConstructOnly) != 0 {
+			continue
+		}
+
+		if !p.field(tp.instanceStruct(instance.obj)).IsZero() {
+			// set by the constructor
+			continue
+		}
+
+		v := InitValue(p.spec.ValueType())
+		ParamValueSetDefault(p.spec, v)
+
+		tp.set(instance, p, v)
+	}
+}
+
+// get sets the value of the property field to value.
+func (tp *taggedProperties) get(instance *activeInstance, p *taggedProperty, value *Value) {
+	instance.propertiesMu.Lock()
+	f := p.field(tp.instanceStruct(instance.obj))
+	goValue := f.Interface()
+	isNil := isNilValue(f)
+	instance.propertiesMu.Unlock()
+
+	if isNil {
+		// the value is already initialized with NULL
+		return
+	}
+
+	value.SetGoValue(goValue)
+}
+
+// set sets the property field to value and returns true if the field value changed.
+func (tp *taggedProperties) set(instance *activeInstance, p *taggedProperty, value *Value) bool {
+	goValue := value.GoValue()
+
+	var newValue reflect.Value
+
+	if goValue == nil {
+		newValue = reflect.Zero(p.fieldType)
+	} else {
+		rv := reflect.ValueOf(goValue)
+
+		if !rv.CanConvert(p.fieldType) {
+			log.Panicf("property %s: cannot convert %T to %s", p.name, goValue, p.fieldType)
+		}
+
+		newValue = rv.Convert(p.fieldType)
+	}
+
+	instance.propertiesMu.Lock()
+	defer instance.propertiesMu.Unlock()
+
+	f := p.field(tp.instanceStruct(instance.obj))
+
+	changed := !samePropertyValue(f, newValue)
+
+	f.Set(newValue)
+
+	return changed
+}
+
+// samePropertyValue returns true if a and b contain the same value. Objects are compared by their
+// GObject instead of the go wrapper.
+func samePropertyValue(a, b reflect.Value) bool {
+	if isNilValue(a) || isNilValue(b) {
+		return isNilValue(a) == isNilValue(b)
+	}
+
+	aObj, aOk := a.Interface().(Object)
+	bObj, bOk := b.Interface().(Object)
+
+	if aOk && bOk {
+		return aObj.baseObject().native == bObj.baseObject().native
+	}
+
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// parseOption parses the option with the given key of the tag, or returns def if it is not set.
+func parseOption[T any](p *taggedProperty, key string, def T, parse func(string) (T, error)) (T, error) {
+	s, ok := p.options[key]
+	if !ok {
+		return def, nil
+	}
+
+	v, err := parse(s)
+	if err != nil {
+		return def, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	return v, nil
+}
+
+func parseInt(bits int) func(string) (int64, error) {
+	return func(s string) (int64, error) { return strconv.ParseInt(s, 10, bits) }
+}
+
+func parseUint(bits int) func(string) (uint64, error) {
+	return func(s string) (uint64, error) { return strconv.ParseUint(s, 10, bits) }
+}
+
+func parseFloat(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+// intRange parses min, max and default of a signed integer property. The default value defaults to
+// 0, or to min or max if 0 is not in the range.
+func intRange(p *taggedProperty, lo, hi int64) (min, max, def int64, err error) {
+	if min, err = parseOption(p, "min", lo, parseInt(64)); err != nil {
+		return
+	}
+	if max, err = parseOption(p, "max", hi, parseInt(64)); err != nil {
+		return
+	}
+	if min < lo || max > hi || min > max {
+		return min, max, def, fmt.Errorf("invalid range %d..%d", min, max)
+	}
+	if def, err = parseOption(p, "default", clamp(0, min, max), parseInt(64)); err != nil {
+		return
+	}
+	if def < min || def > max {
+		err = fmt.Errorf("default %d not in range %d..%d", def, min, max)
+	}
+	return
+}
+
+// uintRange parses min, max and default of an unsigned integer property.
+func uintRange(p *taggedProperty, hi uint64) (min, max, def uint64, err error) {
+	if min, err = parseOption(p, "min", 0, parseUint(64)); err != nil {
+		return
+	}
+	if max, err = parseOption(p, "max", hi, parseUint(64)); err != nil {
+		return
+	}
+	if max > hi || min > max {
+		return min, max, def, fmt.Errorf("invalid range %d..%d", min, max)
+	}
+	if def, err = parseOption(p, "default", min, parseUint(64)); err != nil {
+		return
+	}
+	if def < min || def > max {
+		err = fmt.Errorf("default %d not in range %d..%d", def, min, max)
+	}
+	return
+}
+
+// floatRange parses min, max and default of a floating point property.
+func floatRange(p *taggedProperty, hi float64) (min, max, def float64, err error) {
+	if min, err = parseOption(p, "min", -hi, parseFloat); err != nil {
+		return
+	}
+	if max, err = parseOption(p, "max", hi, parseFloat); err != nil {
+		return
+	}
+	if min < -hi || max > hi || min > max {
+		return min, max, def, fmt.Errorf("invalid range %g..%g", min, max)
+	}
+	if def, err = parseOption(p, "default", clamp(0, min, max), parseFloat); err != nil {
+		return
+	}
+	if def < min || def > max {
+		err = fmt.Errorf("default %g not in range %g..%g", def, min, max)
+	}
+	return
+}
+
+func clamp[T int64 | float64](v, lo, hi T) T {
+	return min(max(v, lo), hi)
+}
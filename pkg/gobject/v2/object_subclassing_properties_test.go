@@ -0,0 +1,86 @@
+package gobject
+
+import "testing"
+
+type propertyTestParent struct {
+	ObjectInstance
+
+	Name  string `gprop:"name,default=parent"`
+	Count int32  `gprop:"count,min=0,max=100"`
+}
+
+type propertyTestChild struct {
+	propertyTestParent
+
+	Label string `gprop:"label"`
+	Size  int32  `gprop:"size,default=5"`
+}
+
+var propertyTestParentType = RegisterObjectSubClass[*propertyTestParent](
+	"GoglibPropertyTestParent",
+	nil,
+	nil,
+	ObjectOverrides[*propertyTestParent]{},
+	nil,
+)
+
+// propertyTestChildType is a go subclass of a go subclass, the tagged properties of both classes use
+// the same property ids.
+var propertyTestChildType = UnsafeRegisterSubClass[*propertyTestChild, *ObjectClass, ObjectOverrides[*propertyTestChild]](
+	"GoglibPropertyTestChild",
+	nil,
+	nil,
+	ObjectOverrides[*propertyTestChild]{},
+	nil,
+	propertyTestParentType,
+	UnsafeObjectClassFromGlibBorrow,
+	UnsafeApplyObjectOverrides[*propertyTestChild],
+	func(obj *ObjectInstance) Object {
+		return obj.UnsafeLoadInstanceFromPrivateData()
+	},
+)
+
+func TestTaggedPropertiesOfSubclassOfSubclass(t *testing.T) {
+	obj := NewObjectWithProperties(propertyTestChildType, map[string]any{
+		"name":  "n",
+		"count": int32(3),
+		"label": "l",
+	})
+
+	child := obj.baseObject().UnsafeLoadInstanceFromPrivateData().(*propertyTestChild)
+
+	if child.Name != "n" || child.Count != 3 || child.Label != "l" || child.Size != 5 {
+		t.Fatalf("Unexpected fields after construction: %+v", child)
+	}
+
+	obj.SetObjectProperty("count", int32(7))
+	obj.SetObjectProperty("size", int32(9))
+
+	if child.Count != 7 || child.Size != 9 {
+		t.Fatalf("Unexpected fields after set: count %d, size %d", child.Count, child.Size)
+	}
+
+	if count := ObjectPropertyAs[int32](obj, "count"); count != 7 {
+		t.Fatalf("Expected count 7, got %d", count)
+	}
+
+	if name := ObjectPropertyAs[string](obj, "name"); name != "n" {
+		t.Fatalf("Expected name n, got %s", name)
+	}
+
+	if size := ObjectPropertyAs[int32](obj, "size"); size != 9 {
+		t.Fatalf("Expected size 9, got %d", size)
+	}
+}
+
+func TestTaggedPropertiesOfSubclassOfSubclassDefaults(t *testing.T) {
+	obj := NewObjectWithProperties(propertyTestChildType, nil)
+
+	if name := ObjectPropertyAs[string](obj, "name"); name != "parent" {
+		t.Fatalf("Expected the default name, got %s", name)
+	}
+
+	if size := ObjectPropertyAs[int32](obj, "size"); size != 5 {
+		t.Fatalf("Expected the default size, got %d", size)
+	}
+}
@@ -33,9 +33,17 @@ func dataFromClass(class unsafe.Pointer) *subClassData {
 	return data
 }
 
+// activeInstance is a go instance of a subclass that is referenced by the private data of its GObject.
+type activeInstance struct {
+	obj Object
+
+	// propertiesMu synchronizes the access to the fields of the tagged properties of the instance
+	propertiesMu sync.Mutex
+}
+
 var instanceID uint64 = 0
 var instancesLock sync.RWMutex
-var activeInstances = make(map[uint64]Object)
+var activeInstances = make(map[uint64]*activeInstance)
 
 // saveInstanceInPrivateData saves the instance in the private data of the given object.
 func saveInstanceInPrivateData(obj Object) {
@@ -55,11 +63,16 @@ func saveInstanceInPrivateData(obj Object) {
 	private := (*uint64)(privatePtr)
 
 	*private = instanceID
-	activeInstances[instanceID] = obj
+	activeInstances[instanceID] = &activeInstance{obj: obj}
 }
 
 // UnsafeLoadInstanceFromPrivateData loads the instance from the private data of the given object.
 func (obj *ObjectInstance) UnsafeLoadInstanceFromPrivateData() Object {
+	return obj.loadActiveInstance().obj
+}
+
+// loadActiveInstance loads the active instance from the private data of the given object.
+func (obj *ObjectInstance) loadActiveInstance() *activeInstance {
 	instancesLock.RLock()
 	defer instancesLock.RUnlock()
 
@@ -7,7 +7,12 @@ import (
 
 // #cgo pkg-config: gobject-2.0
 // #cgo CFLAGS: -Wno-deprecated-declarations
+// #include <stdlib.h>
 // #include <glib-object.h>
+//
+// static GType _goglib_param_spec_type(GParamSpec *pspec) {
+//   return G_PARAM_SPEC_TYPE(pspec);
+// }
 import "C"
 
 func init() {
@@ -19,6 +24,11 @@ func init() {
 func marshalParamSpec(p unsafe.Pointer) (any, error) {
 	native := ValueFromNative(p).Param()
 
+	// empty GValues, e.g. the zero value of G_TYPE_PARAM, contain no param spec
+	if native == nil {
+		return nil, nil
+	}
+
 	return UnsafeParamSpecFromGlibNone(native), nil
 }
 
@@ -31,12 +41,20 @@ type paramSpec struct {
 }
 
 func UnsafeParamSpecFromGlibBorrow(paramspec unsafe.Pointer) *ParamSpec {
+	if paramspec == nil {
+		return nil
+	}
+
 	return &ParamSpec{
 		paramSpec: &paramSpec{(*C.GParamSpec)(paramspec)},
 	}
 }
 
 func UnsafeParamSpecFromGlibFull(p unsafe.Pointer) *ParamSpec {
+	if p == nil {
+		return nil
+	}
+
 	pspec := UnsafeParamSpecFromGlibBorrow(p)
 
 	runtime.SetFinalizer(pspec.paramSpec, func(p *paramSpec) {
@@ -47,11 +65,13 @@ func UnsafeParamSpecFromGlibFull(p unsafe.Pointer) *ParamSpec {
 }
 
 func UnsafeParamSpecFromGlibNone(p unsafe.Pointer) *ParamSpec {
-	pspec := UnsafeParamSpecFromGlibBorrow(p)
+	if p == nil {
+		return nil
+	}
 
-	C.g_param_spec_ref(pspec.native)
+	C.g_param_spec_ref((*C.GParamSpec)(p))
 
-	return pspec
+	return UnsafeParamSpecFromGlibFull(p)
 }
 
 func UnsafeParamSpecToGlibFull(p *ParamSpec) unsafe.Pointer {
@@ -69,6 +89,11 @@ func (p *ParamSpec) Name() string {
 	return C.GoString(C.g_param_spec_get_name(p.native))
 }
 
+// Nick returns the nickname of this parameter.
+func (p *ParamSpec) Nick() string {
+	return C.GoString(C.g_param_spec_get_nick(p.native))
+}
+
 // Blurb returns the blurb for this parameter.
 func (p *ParamSpec) Blurb() string {
 	return C.GoString(C.g_param_spec_get_blurb(p.native))
@@ -89,6 +114,115 @@ func (p *ParamSpec) OwnerType() Type {
 	return Type(p.native.owner_type)
 }
 
+// SpecType returns the GType of the parameter spec itself, e.g. GParamInt. Use ValueType for
+// the type of the values.
+func (p *ParamSpec) SpecType() Type {
+	return Type(C._goglib_param_spec_type(p.native))
+}
+
+// DefaultValue returns the default value of this parameter as go value. This wraps
+// g_param_spec_get_default_value.
+//
+// see also https://docs.gtk.org/gobject/method.ParamSpec.get_default_value.html
+func (p *ParamSpec) DefaultValue() any {
+	cval := C.g_param_spec_get_default_value(p.native)
+	runtime.KeepAlive(p)
+
+	return ValueFromNative(unsafe.Pointer(cval)).GoValue()
+}
+
+// RedirectTarget returns the parameter spec that this parameter redirects to, or nil. This
+// is set for the parameters created with ParamSpecOverride. This wraps g_param_spec_get_redirect_target.
+//
+// see also https://docs.gtk.org/gobject/method.ParamSpec.get_redirect_target.html
+func (p *ParamSpec) RedirectTarget() *ParamSpec {
+	cret := C.g_param_spec_get_redirect_target(p.native)
+	runtime.KeepAlive(p)
+
+	if cret == nil {
+		return nil
+	}
+
+	return UnsafeParamSpecFromGlibNone(unsafe.Pointer(cret))
+}
+
+// Minimum returns the minimum value of numeric parameters, or nil if the parameter
+// has no range. The go type of the value is the same as the type of the values
+// returned by Value.GoValue, e.g. int for GParamInt and float64 for GParamDouble.
+func (p *ParamSpec) Minimum() any {
+	minimum, _ := p.valueRange()
+	return minimum
+}
+
+// Maximum returns the maximum value of numeric parameters, or nil if the parameter
+// has no range, see Minimum.
+func (p *ParamSpec) Maximum() any {
+	_, maximum := p.valueRange()
+	return maximum
+}
+
+// valueRange reads the range from the C struct of the numeric parameter spec types.
+func (p *ParamSpec) valueRange() (minimum, maximum any) {
+	defer runtime.KeepAlive(p)
+
+	ptr := unsafe.Pointer(p.native)
+
+	switch p.SpecType().Name() {
+	case "GParamChar":
+		s := (*C.GParamSpecChar)(ptr)
+		return int8(s.minimum), int8(s.maximum)
+	case "GParamUChar":
+		s := (*C.GParamSpecUChar)(ptr)
+		return uint8(s.minimum), uint8(s.maximum)
+	case "GParamInt":
+		s := (*C.GParamSpecInt)(ptr)
+		return int(s.minimum), int(s.maximum)
+	case "GParamUInt":
+		s := (*C.GParamSpecUInt)(ptr)
+		return uint(s.minimum), uint(s.maximum)
+	case "GParamLong":
+		s := (*C.GParamSpecLong)(ptr)
+		return int64(s.minimum), int64(s.maximum)
+	case "GParamULong":
+		s := (*C.GParamSpecULong)(ptr)
+		return uint(s.minimum), uint(s.maximum)
+	case "GParamInt64":
+		s := (*C.GParamSpecInt64)(ptr)
+		return int64(s.minimum), int64(s.maximum)
+	case "GParamUInt64":
+		s := (*C.GParamSpecUInt64)(ptr)
+		return uint64(s.minimum), uint64(s.maximum)
+	case "GParamFloat":
+		s := (*C.GParamSpecFloat)(ptr)
+		return float32(s.minimum), float32(s.maximum)
+	case "GParamDouble":
+		s := (*C.GParamSpecDouble)(ptr)
+		return float64(s.minimum), float64(s.maximum)
+	}
+
+	return nil, nil
+}
+
+// EnumMembers returns the members of the enum type of enum parameters, or nil for
+// other parameters.
+func (p *ParamSpec) EnumMembers() []EnumMember {
+	if FundamentalType(p.ValueType()) != TypeEnum {
+		return nil
+	}
+
+	return EnumMembers(p.ValueType())
+}
+
+// FlagsMembers returns the members of the flags type of flags parameters, or nil for
+// other parameters.
+func (p *ParamSpec) FlagsMembers() []FlagsMember {
+	if FundamentalType(p.ValueType()) != TypeBitflags {
+		return nil
+	}
+
+	return FlagsMembers(p.ValueType())
+}
+
 // UnsafeRef adds a reference to the parameter spec. This will leak if not paired with
 // a call to UnsafeUnref.
 func (p *ParamSpec) UnsafeRef() {
@@ -102,3 +236,18 @@ func (p *ParamSpec) UnsafeRef() {
 func (p *ParamSpec) UnsafeUnref() {
 	C.g_param_spec_unref(p.native)
 }
+
+// ParamSpecOverride wraps g_param_spec_override. The returned parameter redirects all operations
+// to overridden, it is used to implement the properties of interfaces or to override the
+// properties of the parent class.
+//
+// see also https://docs.gtk.org/gobject/func.g_param_spec_override.html
+func ParamSpecOverride(name string, overridden *ParamSpec) *ParamSpec {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	cret := C.g_param_spec_override((*C.gchar)(cname), overridden.native)
+	runtime.KeepAlive(overridden)
+
+	return UnsafeParamSpecFromGlibFull(unsafe.Pointer(cret))
+}
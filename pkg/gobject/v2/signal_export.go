@@ -3,6 +3,7 @@ package gobject
 import (
 	"unsafe"
 
+	"github.com/go-gst/go-glib/pkg/core"
 	"github.com/go-gst/go-glib/pkg/core/userdata"
 )
 
@@ -16,6 +17,8 @@ func _goglib_signalAccumulator(
 	handler_return *C.GValue,
 	data C.gpointer,
 ) C.gboolean {
+	defer core.RecoverCallback("_goglib_signalAccumulator")
+
 	goAccuI := userdata.Load(unsafe.Pointer(data))
 
 	goAccu := goAccuI.(SignalAccumulator)
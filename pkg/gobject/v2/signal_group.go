@@ -0,0 +1,73 @@
+package gobject
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/core/closure"
+)
+
+// #include <glib-object.h>
+// static GType _goglib_signal_group_target_type(GSignalGroup *group) {
+//   GType t = G_TYPE_INVALID;
+//   g_object_get(group, "target-type", &t, NULL);
+//   return t;
+// }
+import "C"
+
+// SignalGroupExtManual contains the handwritten methods of [SignalGroup].
+type SignalGroupExtManual interface {
+	// ConnectTarget connects f to the detailed signal of the target of the group. The handler is connected
+	// to every target that is set with SetTarget and disconnected when the target is replaced.
+	ConnectTarget(detailedSignal string, f any)
+	// ConnectTargetAfter works like ConnectTarget, but f is invoked after the default handler.
+	ConnectTargetAfter(detailedSignal string, f any)
+	// TargetType returns the GType of the targets of the group.
+	TargetType() Type
+}
+
+// ConnectTarget wraps g_signal_group_connect_closure. f must be a function with one parameter for the target
+// and one for each signal parameter. The signature of f is validated against the signal of the target type,
+// a mismatch causes a runtime panic, see [ValidateSignalHandler].
+//
+// The handlers are connected to every target of the group. There are no SignalHandles, the handlers stay
+// in the group until the group is finalized. Use Block and Unblock to pause all handlers.
+//
+// see also https://docs.gtk.org/gobject/method.SignalGroup.connect_closure.html
+func (self *SignalGroupInstance) ConnectTarget(detailedSignal string, f any) {
+	self.connectTargetClosure(false, detailedSignal, f)
+}
+
+// ConnectTargetAfter works like ConnectTarget, but f is invoked after the default handler of the signal.
+//
+// see also https://docs.gtk.org/gobject/method.SignalGroup.connect_closure.html
+func (self *SignalGroupInstance) ConnectTargetAfter(detailedSignal string, f any) {
+	self.connectTargetClosure(true, detailedSignal, f)
+}
+
+func (self *SignalGroupInstance) connectTargetClosure(after bool, detailedSignal string, f any) {
+	fs := closure.NewFuncStack(f, 2)
+
+	if err := validateHandlerForType(self.TargetType(), detailedSignal, fs.Value().Type()); err != nil {
+		fs.Panicf("%v", err)
+	}
+
+	cstr := C.CString(detailedSignal)
+	defer C.free(unsafe.Pointer(cstr))
+
+	gclosure := closureNew()
+	defer C.g_closure_unref(gclosure)
+
+	closure.Register(unsafe.Pointer(gclosure), fs)
+
+	C.g_signal_group_connect_closure((*C.GSignalGroup)(UnsafeSignalGroupToGlibNone(self)), (*C.gchar)(cstr), gclosure, gbool(after))
+	runtime.KeepAlive(self)
+}
+
+// TargetType returns the GType of the targets of the group, that was passed to NewSignalGroup.
+func (self *SignalGroupInstance) TargetType() Type {
+	t := C._goglib_signal_group_target_type((*C.GSignalGroup)(UnsafeSignalGroupToGlibNone(self)))
+	runtime.KeepAlive(self)
+
+	return Type(t)
+}
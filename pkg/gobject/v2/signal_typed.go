@@ -0,0 +1,319 @@
+package gobject
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+)
+
+// TypedSignal is a signal with a signature that is known at compile time. It is created with
+// [DefineSignal] and makes sure that emitting and connecting always use the signature
+// the signal was registered with.
+type TypedSignal[Args any, Ret any] struct {
+	name  string
+	flags SignalFlags
+
+	// Accumulator is the SignalAccumulator of the signal. It must be set before the signal
+	// gets registered. Can be nil.
+	Accumulator SignalAccumulator
+
+	argsType   reflect.Type
+	paramTypes []Type
+	returnType Type
+}
+
+// DefineSignal creates the definition of a signal with the given name and flags. The GTypes of the
+// signal parameters are derived from the fields of Args, which must be a struct. Ret is the return
+// type of the signal, use struct{} for signals without parameters or return value.
+//
+// The GType of a field is taken from the go type of the field:
+//
+//   - go primitives map to their fundamental types, e.g. int to G_TYPE_INT, string to G_TYPE_STRING
+//   - types implementing [GoValueInitializer] without needing an instance, e.g. generated enums, flags and boxed records
+//   - generated object interfaces and instance pointers, e.g. [Object] or *[ObjectInstance]
+//
+// If the GType can not be derived, e.g. for GInterfaces that are implemented by multiple unrelated
+// classes, it can be given by name with a `gtype:"GstChildProxy"` struct tag.
+//
+// The returned TypedSignal must be registered once, either by passing [TypedSignal.Definition] to the
+// signals of the subclass registration, or by calling [TypedSignal.Register]. DefineSignal panics if
+// a GType can not be derived.
+func DefineSignal[Args any, Ret any](name string, flags SignalFlags) *TypedSignal[Args, Ret] {
+	argsType := reflect.TypeFor[Args]()
+
+	if argsType.Kind() != reflect.Struct {
+		log.Panicf("signal %s: Args must be a struct, got %s", name, argsType)
+	}
+
+	paramTypes := make([]Type, 0, argsType.NumField())
+
+	for i := range argsType.NumField() {
+		field := argsType.Field(i)
+
+		if !field.IsExported() {
+			log.Panicf("signal %s: field %s of %s must be exported", name, field.Name, argsType)
+		}
+
+		t, err := signalTypeForField(field)
+
+		if err != nil {
+			log.Panicf("signal %s: field %s: %v", name, field.Name, err)
+		}
+
+		paramTypes = append(paramTypes, t)
+	}
+
+	returnType := TypeNone
+
+	if retType := reflect.TypeFor[Ret](); !isNoneType(retType) {
+		var err error
+		returnType, err = gtypeForGoType(retType)
+
+		if err != nil {
+			log.Panicf("signal %s: return value: %v", name, err)
+		}
+	}
+
+	return &TypedSignal[Args, Ret]{
+		name:       name,
+		flags:      flags,
+		argsType:   argsType,
+		paramTypes: paramTypes,
+		returnType: returnType,
+	}
+}
+
+// Name returns the name of the signal.
+func (s *TypedSignal[Args, Ret]) Name() string {
+	return s.name
+}
+
+// ParamTypes returns the GTypes of the signal parameters, without the instance parameter.
+func (s *TypedSignal[Args, Ret]) ParamTypes() []Type {
+	return append([]Type(nil), s.paramTypes...)
+}
+
+// ReturnType returns the GType of the signal return value, or TypeNone.
+func (s *TypedSignal[Args, Ret]) ReturnType() Type {
+	return s.returnType
+}
+
+// Definition returns the SignalDefinition that can be added to the signals of a new subclass.
+// The name of the signal must be used as the key.
+func (s *TypedSignal[Args, Ret]) Definition() SignalDefinition {
+	return SignalDefinition{
+		Flags:       s.flags,
+		ParamTypes:  s.ParamTypes(),
+		ReturnType:  s.returnType,
+		Accumulator: s.Accumulator,
+	}
+}
+
+// Register registers the signal for the given type. Use this if the signal is not registered
+// via [TypedSignal.Definition] while registering a subclass.
+func (s *TypedSignal[Args, Ret]) Register(t Type) *Signal {
+	return NewSignal(s.name, t, s.flags, nil, s.Accumulator, s.ParamTypes(), s.returnType)
+}
+
+// Emit emits the signal on obj and returns the return value of the emission.
+func (s *TypedSignal[Args, Ret]) Emit(obj Object, args Args) Ret {
+	argv := reflect.ValueOf(args)
+
+	ret := obj.baseObject().emitDetailed(s.name, func(paramTypes []Type) []*Value {
+		if len(paramTypes) != len(s.paramTypes) {
+			log.Panicf("signal %s has %d parameters, but was defined with %d", s.name, len(paramTypes), len(s.paramTypes))
+		}
+
+		values := make([]*Value, 0, len(paramTypes))
+
+		for i, t := range paramTypes {
+			if t != s.paramTypes[i] {
+				log.Panicf("signal %s parameter %d has type %s, but was defined with %s", s.name, i, t, s.paramTypes[i])
+			}
+
+			v, err := signalParamValue(t, argv.Field(i).Interface())
+
+			if err != nil {
+				log.Panicf("signal %s: field %s: %v", s.name, s.argsType.Field(i).Name, err)
+			}
+
+			values = append(values, v)
+		}
+
+		return values
+	})
+
+	if s.returnType == TypeNone {
+		var zero Ret
+		return zero
+	}
+
+	r, ok := goValueAs[Ret](ret)
+
+	if !ok {
+		log.Panicf("signal %s: cannot convert return value %T to %s", s.name, ret, reflect.TypeFor[Ret]())
+	}
+
+	return r
+}
+
+// Connect connects fn to the signal on obj. fn gets the signal parameters as Args.
+func (s *TypedSignal[Args, Ret]) Connect(obj Object, fn func(Args) Ret) SignalHandle {
+	return obj.Connect(s.name, s.handler(fn))
+}
+
+// ConnectAfter connects fn to the signal on obj, it will be invoked after the default handler.
+func (s *TypedSignal[Args, Ret]) ConnectAfter(obj Object, fn func(Args) Ret) SignalHandle {
+	return obj.ConnectAfter(s.name, s.handler(fn))
+}
+
+// ConnectGroup connects fn to the signal of every target of the group, see [SignalGroupInstance.ConnectTarget].
+func (s *TypedSignal[Args, Ret]) ConnectGroup(group SignalGroup, fn func(Args) Ret) {
+	group.ConnectTarget(s.name, s.handler(fn))
+}
+
+// ConnectGroupAfter connects fn to the signal of every target of the group, it will be invoked after the
+// default handler.
+func (s *TypedSignal[Args, Ret]) ConnectGroupAfter(group SignalGroup, fn func(Args) Ret) {
+	group.ConnectTargetAfter(s.name, s.handler(fn))
+}
+
+// handler creates a func with one parameter per field of Args, that can be connected
+// to the untyped signal.
+func (s *TypedSignal[Args, Ret]) handler(fn func(Args) Ret) any {
+	in := make([]reflect.Type, 0, len(s.paramTypes)+1)
+
+	// the first parameter is always the instance
+	in = append(in, reflect.TypeFor[Object]())
+
+	for i := range s.argsType.NumField() {
+		in = append(in, s.argsType.Field(i).Type)
+	}
+
+	var out []reflect.Type
+
+	if s.returnType != TypeNone {
+		out = append(out, reflect.TypeFor[Ret]())
+	}
+
+	fType := reflect.FuncOf(in, out, false)
+
+	return reflect.MakeFunc(fType, func(params []reflect.Value) []reflect.Value {
+		args := reflect.New(s.argsType).Elem()
+
+		for i, p := range params[1:] {
+			args.Field(i).Set(p)
+		}
+
+		ret := fn(args.Interface().(Args))
+
+		if s.returnType == TypeNone {
+			return nil
+		}
+
+		return []reflect.Value{reflect.ValueOf(&ret).Elem()}
+	}).Interface()
+}
+
+// signalTypeForField returns the GType for the struct field of the signal arguments.
+func signalTypeForField(field reflect.StructField) (Type, error) {
+	if name, ok := field.Tag.Lookup("gtype"); ok {
+		t := TypeFromName(name)
+
+		if t == TypeInvalid {
+			return TypeInvalid, fmt.Errorf("unknown gtype %q", name)
+		}
+
+		return t, nil
+	}
+
+	return gtypeForGoType(field.Type)
+}
+
+var (
+	objectInterfaceType = reflect.TypeFor[Object]()
+	initializerType     = reflect.TypeFor[GoValueInitializer]()
+)
+
+// gtypeForGoType derives the GType of values of the given go type.
+func gtypeForGoType(t reflect.Type) (Type, error) {
+	if t.Kind() == reflect.Interface {
+		if t.NumMethod() == 0 {
+			return TypeInvalid, fmt.Errorf("cannot derive gtype of empty interface %s, use a gtype struct tag", t)
+		}
+
+		// object interfaces and GInterfaces, that are implemented by the wrapped classes
+		return objectTypeForGoType(t)
+	}
+
+	if t.Implements(objectInterfaceType) {
+		return objectTypeForGoType(t)
+	}
+
+	zero := reflect.Zero(t).Interface()
+
+	if t.Implements(initializerType) {
+		return zero.(GoValueInitializer).GoValueType(), nil
+	}
+
+	if vt := valueType(zero); vt != TypeInvalid {
+		return vt, nil
+	}
+
+	return TypeInvalid, fmt.Errorf("cannot derive gtype of %s", t)
+}
+
+// objectTypeForGoType returns the GType of the object class that is wrapped by t. If t is an interface,
+// then the most basic class implementing the interface is returned. Unrelated implementing classes
+// are an error, because the GType would be ambiguous.
+func objectTypeForGoType(t reflect.Type) (Type, error) {
+	objectCastingsLock.RLock()
+	defer objectCastingsLock.RUnlock()
+
+	var candidates []Type
+
+	for gtype, cast := range objectCastings {
+		// the casting functions only wrap the instance, so a zero instance can be used to
+		// get the go type
+		wrapped := reflect.TypeOf(cast(&ObjectInstance{}))
+
+		if wrapped == t || (t.Kind() == reflect.Interface && wrapped.Implements(t)) {
+			candidates = append(candidates, gtype)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return TypeInvalid, fmt.Errorf("no object class registered for %s", t)
+	}
+
+	base := candidates[0]
+
+	for _, c := range candidates[1:] {
+		if base.IsA(c) {
+			base = c
+		}
+	}
+
+	for _, c := range candidates {
+		if !c.IsA(base) {
+			return TypeInvalid, fmt.Errorf("%s is implemented by the unrelated classes %s and %s, use a gtype struct tag", t, base, c)
+		}
+	}
+
+	return base, nil
+}
+
+// isNoneType returns true if t has no values to transfer, e.g. struct{}.
+func isNoneType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.NumField() == 0
+}
+
+// isNilValue returns true if v is a nil interface or pointer.
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+
+	return false
+}
@@ -0,0 +1,125 @@
+package gobject
+
+import (
+	"unsafe"
+)
+
+// #include <glib-object.h>
+import "C"
+
+// SignalInfo describes a signal of a type, see [Type.Signals].
+type SignalInfo struct {
+	// ID is the signal id.
+	ID uint
+	// Name is the name of the signal.
+	Name string
+	// Flags are the flags the signal was registered with.
+	Flags SignalFlags
+	// InstanceType is the type the signal was registered for.
+	InstanceType Type
+	// ReturnType is the GType of the return value, TypeNone if the signal does not return a value.
+	ReturnType Type
+	// ParamTypes are the GTypes of the parameters, without the instance.
+	ParamTypes []Type
+}
+
+// Properties returns the properties of the object class or interface t, including the inherited properties
+// of object classes. It returns nil for other types. This wraps g_object_class_list_properties and
+// g_object_interface_list_properties.
+func (t Type) Properties() []*ParamSpec {
+	var n C.guint
+	var cspecs **C.GParamSpec
+
+	switch {
+	case FundamentalType(t) == TypeInterface:
+		iface := C.g_type_default_interface_ref(C.GType(t))
+		defer C.g_type_default_interface_unref(iface)
+
+		cspecs = C.g_object_interface_list_properties(iface, &n)
+	case t.IsA(TypeObject):
+		class := C.g_type_class_ref(C.GType(t))
+		defer C.g_type_class_unref(class)
+
+		cspecs = C.g_object_class_list_properties((*C.GObjectClass)(class), &n)
+	default:
+		return nil
+	}
+
+	defer C.g_free(C.gpointer(cspecs))
+
+	specs := make([]*ParamSpec, 0, n)
+
+	for _, cspec := range unsafe.Slice(cspecs, n) {
+		specs = append(specs, UnsafeParamSpecFromGlibNone(unsafe.Pointer(cspec)))
+	}
+
+	return specs
+}
+
+// Signals returns the signals that are registered for t. Signals of the parent types and the
+// implemented interfaces are not included, walk [Type.Parent] and [Type.Interfaces] to get them.
+// This wraps g_signal_list_ids and g_signal_query.
+func (t Type) Signals() []SignalInfo {
+	// the signals are usually created in the class init function, so the class
+	// must be initialized before the signals can be listed
+	switch {
+	case FundamentalType(t) == TypeInterface:
+		iface := C.g_type_default_interface_ref(C.GType(t))
+		defer C.g_type_default_interface_unref(iface)
+	case C.g_type_test_flags(C.GType(t), C.G_TYPE_FLAG_CLASSED) != 0:
+		class := C.g_type_class_ref(C.GType(t))
+		defer C.g_type_class_unref(class)
+	}
+
+	var n C.guint
+	cids := C.g_signal_list_ids(C.GType(t), &n)
+	defer C.g_free(C.gpointer(cids))
+
+	signals := make([]SignalInfo, 0, n)
+
+	for _, id := range unsafe.Slice(cids, n) {
+		q := querySignal(id)
+
+		signals = append(signals, SignalInfo{
+			ID:           uint(q.id),
+			Name:         q.name,
+			Flags:        q.flags,
+			InstanceType: t,
+			ReturnType:   q.returnType,
+			ParamTypes:   q.paramTypes,
+		})
+	}
+
+	return signals
+}
+
+// Children returns the types that directly derive from t. This wraps g_type_children.
+func (t Type) Children() []Type {
+	var n C.guint
+	c := C.g_type_children(C.GType(t), &n)
+	defer C.g_free(C.gpointer(c))
+
+	if n == 0 {
+		return nil
+	}
+
+	return append([]Type(nil), unsafe.Slice((*Type)(unsafe.Pointer(c)), n)...)
+}
+
+// ClassSize returns the size of the class struct of t in bytes, or 0 if t is not a classed type.
+// This wraps g_type_query.
+func (t Type) ClassSize() uint {
+	var q C.GTypeQuery
+	C.g_type_query(C.GType(t), &q)
+
+	return uint(q.class_size)
+}
+
+// InstanceSize returns the size of the instance struct of t in bytes, or 0 if t is not an
+// instantiatable type. This wraps g_type_query.
+func (t Type) InstanceSize() uint {
+	var q C.GTypeQuery
+	C.g_type_query(C.GType(t), &q)
+
+	return uint(q.instance_size)
+}
@@ -5,6 +5,8 @@ import (
 	"log"
 	"sync"
 	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/glib/v2"
 )
 
 // #cgo pkg-config: glib-2.0
@@ -153,7 +155,7 @@ func init() {
 	RegisterGValueMarshaler(TypeString, marshalString)
 	RegisterGValueMarshaler(TypePointer, marshalPointer)
 	RegisterGValueMarshaler(TypeBoxed, marshalBoxed)
-	// RegisterGValueMarshaler(TypeVariant, marshalVariant)
+	RegisterGValueMarshaler(TypeVariant, marshalVariant)
 	RegisterGValueMarshaler(Type(C.g_value_get_type()), marshalValue)
 
 	// included for completeness, each Bitflag/Enum type should implement it's own marshaller
@@ -341,7 +343,10 @@ func marshalPointer(p unsafe.Pointer) (interface{}, error) {
 	return unsafe.Pointer(c), nil
 }
 
-// func marshalVariant(p unsafe.Pointer) (interface{}, error) {
-// 	c := C.g_value_get_variant((*C.GValue)(unsafe.Pointer(p)))
-// 	return newVariant((*C.GVariant)(c)), nil
-// }
+func marshalVariant(p unsafe.Pointer) (interface{}, error) {
+	c := C.g_value_get_variant((*C.GValue)(unsafe.Pointer(p)))
+	if c == nil {
+		return nil, nil
+	}
+	return glib.UnsafeVariantFromGlibNone(unsafe.Pointer(c)), nil
+}
@@ -0,0 +1,248 @@
+package gobject
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// #include <glib-object.h>
+import "C"
+
+// goTypeModule is the go subclass of GTypeModule that is created by NewTypeModule.
+type goTypeModule struct {
+	TypeModuleInstance
+
+	register   func()
+	registered bool
+
+	// replay contains the registrations of the first load, they are repeated on every
+	// following load of the module
+	replay []func(module *C.GTypeModule)
+}
+
+var (
+	goTypeModuleOnce sync.Once
+	goTypeModuleType Type
+
+	// typeModuleLoadLock is held while a module created by NewTypeModule is loaded, nested loads
+	// on the loading thread do not take it again
+	typeModuleLoadLock sync.Mutex
+
+	// typeModuleLoading contains the modules that are loaded by the thread that holds typeModuleLoadLock,
+	// the types are registered with the innermost one
+	typeModuleLoading struct {
+		mu      sync.Mutex
+		thread  *C.GThread
+		modules []*goTypeModule
+	}
+)
+
+// NewTypeModule creates a GTypeModule with the given name, for types that are used from plugins that are
+// loaded at runtime. register is called when the module is loaded for the first time, e.g. when
+// [TypeModuleInstance.Use] is called or an instance of one of its types is created. Subclasses, enums, flags
+// and interfaces that are registered while register runs are registered with the module as dynamic types,
+// instead of static types:
+//
+//	var myElementType gobject.Type
+//
+//	module := gobject.NewTypeModule("myplugin", func() {
+//		myElementType = RegisterMyElement()
+//	})
+//
+//	module.Use()
+//
+// When the module is unused and loaded again, the same registrations are repeated, register is only called
+// once. Boxed types can not be dynamic, [RegisterBoxed] always registers a static type.
+//
+// Only the registrations of the goroutine that calls register are dynamic, other goroutines, including the
+// ones started by register, register static types. register may use types of other modules, which loads
+// them first. The module is never finalized, as required by GTypeModule.
+func NewTypeModule(name string, register func()) TypeModule {
+	goTypeModuleOnce.Do(func() {
+		goTypeModuleType = RegisterTypeModuleSubClass[*goTypeModule](
+			"GoglibTypeModule",
+			nil,
+			nil,
+			TypeModuleOverrides[*goTypeModule]{
+				Load:   (*goTypeModule).load,
+				Unload: (*goTypeModule).unload,
+			},
+			nil,
+		)
+	})
+
+	obj := NewObjectWithProperties(goTypeModuleType, nil)
+
+	m := obj.baseObject().UnsafeLoadInstanceFromPrivateData().(*goTypeModule)
+	m.register = register
+	m.SetName(name)
+
+	// a GTypeModule must never be finalized, because the type system keeps using it
+	UnsafeObjectRef(obj)
+
+	return m
+}
+
+// load registers the types of the module, this is called by g_type_module_use. The load is a cgo callback,
+// so the registrations of the module run on the calling thread. This identifies the registrations and the
+// nested loads of other modules, e.g. when register uses a type of another module.
+func (m *goTypeModule) load() bool {
+	self := C.g_thread_self()
+
+	typeModuleLoading.mu.Lock()
+	nested := typeModuleLoading.thread == self
+	typeModuleLoading.mu.Unlock()
+
+	if !nested {
+		typeModuleLoadLock.Lock()
+		defer typeModuleLoadLock.Unlock()
+	}
+
+	typeModuleLoading.mu.Lock()
+	typeModuleLoading.thread = self
+	typeModuleLoading.modules = append(typeModuleLoading.modules, m)
+	typeModuleLoading.mu.Unlock()
+
+	defer func() {
+		typeModuleLoading.mu.Lock()
+		defer typeModuleLoading.mu.Unlock()
+
+		typeModuleLoading.modules = typeModuleLoading.modules[:len(typeModuleLoading.modules)-1]
+
+		if len(typeModuleLoading.modules) == 0 {
+			typeModuleLoading.thread = nil
+		}
+	}()
+
+	if m.registered {
+		native := (*C.GTypeModule)(UnsafeTypeModuleToGlibNone(m))
+
+		for _, r := range m.replay {
+			r(native)
+		}
+
+		return true
+	}
+
+	defer func() {
+		// register panicked, the partial registrations must not be replayed on the next load,
+		// which calls register again
+		if !m.registered {
+			m.replay = nil
+		}
+	}()
+
+	if m.register != nil {
+		m.register()
+	}
+
+	m.registered = true
+
+	return true
+}
+
+// unload is called by g_type_module_unuse, the type system marks the types as unloaded.
+func (m *goTypeModule) unload() {}
+
+// loadingTypeModule returns the module that is loaded by the calling thread, or nil if the calling
+// thread does not load a module. Types are registered with this module.
+func loadingTypeModule() *goTypeModule {
+	typeModuleLoading.mu.Lock()
+	defer typeModuleLoading.mu.Unlock()
+
+	if len(typeModuleLoading.modules) == 0 || typeModuleLoading.thread != C.g_thread_self() {
+		return nil
+	}
+
+	return typeModuleLoading.modules[len(typeModuleLoading.modules)-1]
+}
+
+// registerWith calls r with the loading module and keeps it for the following loads.
+func (m *goTypeModule) registerWith(r func(module *C.GTypeModule)) {
+	m.replay = append(m.replay, r)
+
+	r((*C.GTypeModule)(UnsafeTypeModuleToGlibNone(m)))
+}
+
+// registerType wraps g_type_register_static, or g_type_module_register_type while a module created
+// by NewTypeModule is loaded. info is copied.
+func registerType(parent Type, name string, info *C.GTypeInfo, flags C.GTypeFlags) Type {
+	loading := loadingTypeModule()
+	if loading == nil {
+		cname := C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+
+		return Type(C.g_type_register_static(C.GType(parent), (*C.gchar)(cname), info, flags))
+	}
+
+	var gtype C.GType
+
+	loading.registerWith(func(module *C.GTypeModule) {
+		cname := C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+
+		gtype = C.g_type_module_register_type(module, C.GType(parent), (*C.gchar)(cname), info, flags)
+	})
+
+	return Type(gtype)
+}
+
+// addInterface wraps g_type_add_interface_static, or g_type_module_add_interface while a module created
+// by NewTypeModule is loaded. info is copied.
+func addInterface(instanceType, interfaceType Type, info *C.GInterfaceInfo) {
+	loading := loadingTypeModule()
+	if loading == nil {
+		C.g_type_add_interface_static(C.GType(instanceType), C.GType(interfaceType), info)
+		return
+	}
+
+	loading.registerWith(func(module *C.GTypeModule) {
+		C.g_type_module_add_interface(module, C.GType(instanceType), C.GType(interfaceType), info)
+	})
+}
+
+// registerEnum wraps g_enum_register_static, or g_type_module_register_enum while a module created
+// by NewTypeModule is loaded. values must never be freed.
+func registerEnum(name string, values *C.GEnumValue) Type {
+	loading := loadingTypeModule()
+	if loading == nil {
+		cname := C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+
+		return Type(C.g_enum_register_static((*C.gchar)(cname), values))
+	}
+
+	var gtype C.GType
+
+	loading.registerWith(func(module *C.GTypeModule) {
+		cname := C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+
+		gtype = C.g_type_module_register_enum(module, (*C.gchar)(cname), values)
+	})
+
+	return Type(gtype)
+}
+
+// registerFlags wraps g_flags_register_static, or g_type_module_register_flags while a module created
+// by NewTypeModule is loaded. values must never be freed.
+func registerFlags(name string, values *C.GFlagsValue) Type {
+	loading := loadingTypeModule()
+	if loading == nil {
+		cname := C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+
+		return Type(C.g_flags_register_static((*C.gchar)(cname), values))
+	}
+
+	var gtype C.GType
+
+	loading.registerWith(func(module *C.GTypeModule) {
+		cname := C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+
+		gtype = C.g_type_module_register_flags(module, (*C.gchar)(cname), values)
+	})
+
+	return Type(gtype)
+}
@@ -0,0 +1,92 @@
+package gobject
+
+import "testing"
+
+type typeModuleTestObject struct {
+	ObjectInstance
+}
+
+type typeModuleTestEnum int
+
+func TestTypeModuleReload(t *testing.T) {
+	var gtype Type
+	calls := 0
+
+	module := NewTypeModule("GoglibTypeModuleTestReload", func() {
+		calls++
+
+		gtype = RegisterObjectSubClass[*typeModuleTestObject](
+			"GoglibTypeModuleTestObject",
+			nil,
+			nil,
+			ObjectOverrides[*typeModuleTestObject]{},
+			nil,
+		)
+	})
+
+	for i := range 3 {
+		if !module.Use() {
+			t.Fatalf("Use %d failed", i)
+		}
+
+		obj := NewObjectWithProperties(gtype, nil)
+
+		if name := obj.baseObject().typeFromInstance().Name(); name != "GoglibTypeModuleTestObject" {
+			t.Fatalf("Use %d: expected an instance of GoglibTypeModuleTestObject, got %s", i, name)
+		}
+
+		module.Unuse()
+	}
+
+	if calls != 1 {
+		t.Fatalf("Expected register to be called once, got %d", calls)
+	}
+
+	if n := len(module.(*goTypeModule).replay); n != 1 {
+		t.Fatalf("Expected 1 registration to replay, got %d", n)
+	}
+}
+
+func TestTypeModuleLoadPanics(t *testing.T) {
+	calls := 0
+
+	module := NewTypeModule("GoglibTypeModuleTestPanic", func() {
+		calls++
+
+		if calls == 1 {
+			RegisterEnum("GoglibTypeModuleTestEnum", map[typeModuleTestEnum]EnumValue{
+				0: {Name: "GOGLIB_TYPE_MODULE_TEST_ZERO", Nick: "zero"},
+			})
+
+			panic("register failed")
+		}
+	}).(*goTypeModule)
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("Expected load to panic")
+			}
+		}()
+
+		module.load()
+	}()
+
+	if module.registered || len(module.replay) != 0 {
+		t.Fatalf("Expected the failed load to be reset, registered %v, %d registrations to replay", module.registered, len(module.replay))
+	}
+
+	if !module.Use() {
+		t.Fatal("Use after the failed load failed")
+	}
+
+	defer module.Unuse()
+
+	if calls != 2 {
+		t.Fatalf("Expected register to be called again, got %d calls", calls)
+	}
+
+	if len(module.replay) != 0 {
+		t.Fatalf("Expected no registrations to replay, got %d", len(module.replay))
+	}
+}
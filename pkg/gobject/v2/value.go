@@ -4,7 +4,10 @@ import (
 	"log"
 	"reflect"
 	"runtime"
+	"sync"
 	"unsafe"
+
+	"github.com/go-gst/go-glib/pkg/glib/v2"
 )
 
 // #cgo pkg-config: gobject-2.0
@@ -203,6 +206,11 @@ func (v *Value) SetGoValue(goValue any) {
 		return
 	}
 
+	if r, ok := lookupRegisteredGoType(reflect.TypeOf(goValue)); ok {
+		r.set(v, goValue)
+		return
+	}
+
 	if setValuePrimitive(v, goValue) {
 		return
 	}
@@ -264,6 +272,10 @@ func valueType(v any) Type {
 		return TypePointer
 	}
 
+	if r, ok := lookupRegisteredGoType(reflect.TypeOf(v)); ok {
+		return r.gtype
+	}
+
 	if valueTyp := valueTypeForPrimitive(v); valueTyp != TypeInvalid {
 		return valueTyp
 	}
@@ -271,6 +283,33 @@ func valueType(v any) Type {
 	return valueTypeForPrimitiveReflect(v)
 }
 
+// registeredGoType is a go type that was registered as a GType by the bindings, e.g. with
+// RegisterEnum. Values of these types can be used in GValues without implementing GoValueInitializer.
+type registeredGoType struct {
+	gtype Type
+	// set sets the go value to the GValue that was initialized with gtype
+	set func(v *Value, goValue any)
+}
+
+// registeredGoTypes maps reflect.Type to registeredGoType
+var registeredGoTypes sync.Map
+
+// registerGoType registers the go type t for the given GType. It panics if t was already registered.
+func registerGoType(t reflect.Type, r registeredGoType) {
+	if _, loaded := registeredGoTypes.LoadOrStore(t, r); loaded {
+		log.Panicf("go type %s is already registered as a GType", t)
+	}
+}
+
+func lookupRegisteredGoType(t reflect.Type) (registeredGoType, bool) {
+	r, ok := registeredGoTypes.Load(t)
+	if !ok {
+		return registeredGoType{}, false
+	}
+
+	return r.(registeredGoType), true
+}
+
 func valueTypeForPrimitive(v interface{}) Type {
 	switch v.(type) {
 	case bool:
@@ -297,6 +336,8 @@ func valueTypeForPrimitive(v interface{}) Type {
 		return TypeDouble
 	case string:
 		return TypeString
+	case *glib.Variant:
+		return TypeVariant
 	default:
 		return TypeInvalid
 	}
@@ -362,6 +403,8 @@ func setValuePrimitive(val *Value, v interface{}) bool {
 		val.SetDouble(e)
 	case string:
 		val.SetString(e)
+	case *glib.Variant:
+		val.SetVariant(e)
 	default:
 		return false
 	}
@@ -504,6 +547,21 @@ func (v *Value) SetBoxed(p unsafe.Pointer) {
 	runtime.KeepAlive(v)
 }
 
+// SetVariant is a wrapper around g_value_set_variant().
+func (v *Value) SetVariant(variant *glib.Variant) {
+	C.g_value_set_variant(v.native(), (*C.GVariant)(glib.UnsafeVariantToGlibNone(variant)))
+	runtime.KeepAlive(v)
+	runtime.KeepAlive(variant)
+}
+
+// Variant is a wrapper around g_value_get_variant(). It returns nil if the
+// Value does not contain a variant.
+func (v *Value) Variant() *glib.Variant {
+	p := unsafe.Pointer(C.g_value_get_variant(v.native()))
+	runtime.KeepAlive(v)
+	return glib.UnsafeVariantFromGlibNone(p)
+}
+
 // Object is a wrapper around g_value_get_object(). The returned Object is already wrapped
 // in a appropriate extending type, if it was registered before with [RegisterObjectCasting]
 func (v *Value) Object() Object {